@@ -0,0 +1,53 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// BadgeLabel is the label of the badge served by BadgeHandler.
+var BadgeLabel = "version"
+
+type badge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+}
+
+// BadgeHandler is the http.Handler that writes the version of BuildInfo bld as
+// a shields.io endpoint badge JSON response, e.g.
+// {"schemaVersion":1,"label":"version","message":"v1.2.3"}.
+// See https://shields.io/badges/endpoint-badge for details on how to display
+// the badge. Just like NewHandler, the response is prepared once when the
+// handler is created.
+func BadgeHandler(bld *BuildInfo) http.Handler {
+	// marshaling a struct with only string and int fields never returns an
+	// error
+	body, _ := json.Marshal(badge{
+		SchemaVersion: 1,
+		Label:         BadgeLabel,
+		Message:       bld.Version(),
+	})
+	tag := etag(body)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		hdr := w.Header()
+		hdr.Set("ETag", tag)
+		if notModified(req, tag, time.Time{}) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		hdr.Set("Content-Type", "application/json")
+		if req.Method == http.MethodHead {
+			return
+		}
+
+		_, _ = w.Write(body)
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBadgeHandler(t *testing.T) {
+	bld := BuildInfo{AltVersion: "v1.2.3"}
+
+	t.Run("get", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/badge", nil)
+		BadgeHandler(&bld).ServeHTTP(rec, req)
+
+		assert.Exactly(t, http.StatusOK, rec.Code)
+		assert.Exactly(t, "application/json", rec.Header().Get("Content-Type"))
+		assert.Exactly(t,
+			`{"schemaVersion":1,"label":"version","message":"v1.2.3"}`,
+			rec.Body.String(),
+		)
+	})
+	t.Run("not modified", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/badge", nil)
+		req.Header.Set("If-None-Match", etag([]byte(
+			`{"schemaVersion":1,"label":"version","message":"v1.2.3"}`,
+		)))
+		BadgeHandler(&bld).ServeHTTP(rec, req)
+
+		assert.Exactly(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.Bytes())
+	})
+}
@@ -5,6 +5,7 @@
 package buildinfo
 
 import (
+	"debug/buildinfo"
 	"encoding/json"
 	"io"
 	"runtime"
@@ -26,15 +27,30 @@ const (
 	MetricName = "buildinfo"
 	// MetricHelp is the default help text to describe the metric.
 	MetricHelp = "Metric with build information labels and a constant value of '1'."
+	// TimestampMetricName is the default name for the metric with the build
+	// time as Unix timestamp value (without namespace).
+	TimestampMetricName = "build_timestamp_seconds"
+	// TimestampMetricHelp is the default help text to describe the timestamp
+	// metric.
+	TimestampMetricHelp = "Metric with the build time as Unix timestamp in seconds."
 
 	// PathPattern is the default path for a http handler.
 	PathPattern = "/version"
 
+	// HeaderVersion is the http header which contains the version, as set by
+	// Middleware.
+	HeaderVersion = "X-App-Version"
+	// HeaderRevision is the http header which contains the revision, as set by
+	// Middleware.
+	HeaderRevision = "X-App-Revision"
+
 	// reserved keys
 	keyVersion   = "version"
 	keyGoversion = "goversion"
 	keyRevision  = "vcs.revision"
 	keyTime      = "vcs.time"
+	keyBuildNum  = "buildnumber"
+	keyRepoURL   = "repourl"
 )
 
 // EmptyVersion is the default version string when no version is set.
@@ -49,6 +65,12 @@ type BuildInfo struct {
 	AltName string
 	// AltVersion is an alternative version of the release.
 	AltVersion string
+	// BuildNumber is the number of the (CI) build which produced the release,
+	// e.g. the value of GITHUB_RUN_NUMBER or BUILD_NUMBER set via ldflags.
+	BuildNumber string
+	// RepoURL is the url of the source repository of the release, e.g.
+	// https://github.com/go-pogo/buildinfo. See NormalizeRepoURL.
+	RepoURL string
 	// Extra additional information to show.
 	//Extra map[string]string
 }
@@ -64,6 +86,17 @@ func New(altVersion string) (*BuildInfo, error) {
 	return &bld, nil
 }
 
+// ReadFile returns the BuildInfo embedded in the Go binary at path name, like
+// `go version -m` does. It returns an error when the file cannot be read or is
+// not a Go binary with build information.
+func ReadFile(name string) (*BuildInfo, error) {
+	info, err := buildinfo.ReadFile(name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &BuildInfo{info: info}, nil
+}
+
 func (bld *BuildInfo) init() bool {
 	if bld.info != nil {
 		return true
@@ -114,6 +147,9 @@ func (bld *BuildInfo) GoVersion() string {
 	return bld.info.GoVersion
 }
 
+// Name returns AltName, or the last element of the main package's path when
+// AltName is empty. The path of the main module is used when the main
+// package's path is not available.
 func (bld *BuildInfo) Name() string {
 	if bld.AltName != "" {
 		return bld.AltName
@@ -121,7 +157,12 @@ func (bld *BuildInfo) Name() string {
 	if !bld.init() {
 		return ""
 	}
-	return bld.info.Path[:strings.LastIndex(bld.info.Path, "/")+1]
+
+	name := bld.info.Path
+	if name == "" {
+		name = bld.info.Main.Path
+	}
+	return name[strings.LastIndex(name, "/")+1:]
 }
 
 func (bld *BuildInfo) Version() string {
@@ -156,6 +197,12 @@ func (bld *BuildInfo) Map() map[string]string {
 	if tim := bld.Time(); !tim.IsZero() {
 		m[keyTime] = tim.Format(time.RFC3339)
 	}
+	if bld.BuildNumber != "" {
+		m[keyBuildNum] = bld.BuildNumber
+	}
+	if bld.RepoURL != "" {
+		m[keyRepoURL] = bld.RepoURL
+	}
 	return m
 }
 
@@ -200,20 +247,35 @@ func (bld *BuildInfo) MarshalJSON() ([]byte, error) {
 }
 
 func (bld *BuildInfo) writeJson(w io.StringWriter) {
-	_, _ = w.WriteString(`{"version":"`)
-	_, _ = w.WriteString(bld.Version())
+	_, _ = w.WriteString(`{"version":`)
+	writeJsonString(w, bld.Version())
 
 	if rev := bld.Revision(); rev != "" {
-		_, _ = w.WriteString(`","revision":"`)
-		_, _ = w.WriteString(rev)
+		_, _ = w.WriteString(`,"revision":`)
+		writeJsonString(w, rev)
 	}
 	if tim := bld.Time(); !tim.IsZero() {
-		_, _ = w.WriteString(`","time":"`)
+		_, _ = w.WriteString(`,"time":"`)
 		_, _ = w.WriteString(tim.Format(time.RFC3339))
+		_, _ = w.WriteString(`"`)
+	}
+	if bld.BuildNumber != "" {
+		_, _ = w.WriteString(`,"buildnumber":`)
+		writeJsonString(w, bld.BuildNumber)
+	}
+	if bld.RepoURL != "" {
+		_, _ = w.WriteString(`,"repourl":`)
+		writeJsonString(w, bld.RepoURL)
 	}
 
-	_, _ = w.WriteString(`","goversion":"`)
-	_, _ = w.WriteString(bld.GoVersion())
+	_, _ = w.WriteString(`,"goversion":`)
+	writeJsonString(w, bld.GoVersion())
+	_, _ = w.WriteString(`}`)
+}
 
-	_, _ = w.WriteString(`"}`)
+// writeJsonString writes s as a quoted and escaped JSON string to w.
+func writeJsonString(w io.StringWriter, s string) {
+	// marshaling a string never returns an error
+	b, _ := json.Marshal(s)
+	_, _ = w.WriteString(string(b))
 }
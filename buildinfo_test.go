@@ -5,6 +5,7 @@
 package buildinfo
 
 import (
+	"os"
 	"runtime"
 	"runtime/debug"
 	"testing"
@@ -25,6 +26,53 @@ func TestBuildInfo_GoVersion(t *testing.T) {
 	assert.Exactly(t, goVersion, new(BuildInfo).GoVersion())
 }
 
+func TestBuildInfo_Name(t *testing.T) {
+	tests := map[string]struct {
+		input BuildInfo
+		want  string
+	}{
+		"empty": {
+			input: BuildInfo{info: &debug.BuildInfo{}},
+		},
+		"alt name": {
+			input: BuildInfo{
+				info:    &debug.BuildInfo{Main: debug.Module{Path: "github.com/go-pogo/myapp"}},
+				AltName: "other",
+			},
+			want: "other",
+		},
+		"package path": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					Path: "github.com/go-pogo/myapp",
+					Main: debug.Module{Path: "github.com/go-pogo/myapp"},
+				},
+			},
+			want: "myapp",
+		},
+		"cmd/api": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					Path: "github.com/go-pogo/myapp/cmd/api",
+					Main: debug.Module{Path: "github.com/go-pogo/myapp"},
+				},
+			},
+			want: "api",
+		},
+		"module path": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{Main: debug.Module{Path: "github.com/go-pogo/myapp"}},
+			},
+			want: "myapp",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Exactly(t, tc.want, tc.input.Name())
+		})
+	}
+}
+
 func TestBuildInfo_String(t *testing.T) {
 	tests := map[string]struct {
 		input BuildInfo
@@ -119,6 +167,47 @@ var tests = map[string]struct {
 		},
 		wantJson: `{"version":"v0.66","revision":"abcdefghi","time":"2020-06-16T19:53:00Z","goversion":"` + goVersion + `"}`,
 	},
+	"build number": {
+		wantStruct: BuildInfo{
+			info:        &debug.BuildInfo{},
+			AltVersion:  "v0.66",
+			BuildNumber: "457",
+		},
+		wantMap: map[string]string{
+			keyVersion:   "v0.66",
+			keyGoversion: goVersion,
+			keyBuildNum:  "457",
+		},
+		wantJson: `{"version":"v0.66","buildnumber":"457","goversion":"` + goVersion + `"}`,
+	},
+	"repo url": {
+		wantStruct: BuildInfo{
+			info:       &debug.BuildInfo{},
+			AltVersion: "v0.66",
+			RepoURL:    "https://github.com/go-pogo/buildinfo",
+		},
+		wantMap: map[string]string{
+			keyVersion:   "v0.66",
+			keyGoversion: goVersion,
+			keyRepoURL:   "https://github.com/go-pogo/buildinfo",
+		},
+		wantJson: `{"version":"v0.66","repourl":"https://github.com/go-pogo/buildinfo","goversion":"` + goVersion + `"}`,
+	},
+	"escaped": {
+		wantStruct: BuildInfo{
+			info:        &debug.BuildInfo{},
+			AltVersion:  `v0.66"`,
+			BuildNumber: `4"5\7`,
+			RepoURL:     "https://example.com/\"repo\"",
+		},
+		wantMap: map[string]string{
+			keyVersion:   `v0.66"`,
+			keyGoversion: goVersion,
+			keyBuildNum:  `4"5\7`,
+			keyRepoURL:   "https://example.com/\"repo\"",
+		},
+		wantJson: `{"version":"v0.66\"","buildnumber":"4\"5\\7","repourl":"https://example.com/\"repo\"","goversion":"` + goVersion + `"}`,
+	},
 }
 
 func TestBuildInfo_Map(t *testing.T) {
@@ -139,3 +228,22 @@ func TestBuildInfo_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestReadFile(t *testing.T) {
+	t.Run("executable", func(t *testing.T) {
+		exe, err := os.Executable()
+		if err != nil {
+			t.Skip(err)
+		}
+
+		have, err := ReadFile(exe)
+		assert.NoError(t, err)
+		assert.Exactly(t, runtime.Version(), have.GoVersion())
+		assert.Exactly(t, "github.com/go-pogo/buildinfo", have.Module("main").Path)
+	})
+	t.Run("not a binary", func(t *testing.T) {
+		have, err := ReadFile("buildinfo.go")
+		assert.Error(t, err)
+		assert.Nil(t, have)
+	})
+}
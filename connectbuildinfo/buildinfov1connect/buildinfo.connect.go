@@ -0,0 +1,115 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buildinfov1/buildinfo.proto
+
+package buildinfov1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	buildinfov1 "github.com/go-pogo/buildinfo/grpcbuildinfo/buildinfov1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BuildInfoServiceName is the fully-qualified name of the BuildInfoService service.
+	BuildInfoServiceName = "buildinfo.v1.BuildInfoService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BuildInfoServiceGetBuildInfoProcedure is the fully-qualified name of the BuildInfoService's
+	// GetBuildInfo RPC.
+	BuildInfoServiceGetBuildInfoProcedure = "/buildinfo.v1.BuildInfoService/GetBuildInfo"
+)
+
+// BuildInfoServiceClient is a client for the buildinfo.v1.BuildInfoService service.
+type BuildInfoServiceClient interface {
+	// GetBuildInfo returns the build information of the running service.
+	GetBuildInfo(context.Context, *connect.Request[buildinfov1.GetBuildInfoRequest]) (*connect.Response[buildinfov1.GetBuildInfoResponse], error)
+}
+
+// NewBuildInfoServiceClient constructs a client for the buildinfo.v1.BuildInfoService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBuildInfoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BuildInfoServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	buildInfoServiceMethods := buildinfov1.File_buildinfov1_buildinfo_proto.Services().ByName("BuildInfoService").Methods()
+	return &buildInfoServiceClient{
+		getBuildInfo: connect.NewClient[buildinfov1.GetBuildInfoRequest, buildinfov1.GetBuildInfoResponse](
+			httpClient,
+			baseURL+BuildInfoServiceGetBuildInfoProcedure,
+			connect.WithSchema(buildInfoServiceMethods.ByName("GetBuildInfo")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// buildInfoServiceClient implements BuildInfoServiceClient.
+type buildInfoServiceClient struct {
+	getBuildInfo *connect.Client[buildinfov1.GetBuildInfoRequest, buildinfov1.GetBuildInfoResponse]
+}
+
+// GetBuildInfo calls buildinfo.v1.BuildInfoService.GetBuildInfo.
+func (c *buildInfoServiceClient) GetBuildInfo(ctx context.Context, req *connect.Request[buildinfov1.GetBuildInfoRequest]) (*connect.Response[buildinfov1.GetBuildInfoResponse], error) {
+	return c.getBuildInfo.CallUnary(ctx, req)
+}
+
+// BuildInfoServiceHandler is an implementation of the buildinfo.v1.BuildInfoService service.
+type BuildInfoServiceHandler interface {
+	// GetBuildInfo returns the build information of the running service.
+	GetBuildInfo(context.Context, *connect.Request[buildinfov1.GetBuildInfoRequest]) (*connect.Response[buildinfov1.GetBuildInfoResponse], error)
+}
+
+// NewBuildInfoServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBuildInfoServiceHandler(svc BuildInfoServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	buildInfoServiceMethods := buildinfov1.File_buildinfov1_buildinfo_proto.Services().ByName("BuildInfoService").Methods()
+	buildInfoServiceGetBuildInfoHandler := connect.NewUnaryHandler(
+		BuildInfoServiceGetBuildInfoProcedure,
+		svc.GetBuildInfo,
+		connect.WithSchema(buildInfoServiceMethods.ByName("GetBuildInfo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buildinfo.v1.BuildInfoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BuildInfoServiceGetBuildInfoProcedure:
+			buildInfoServiceGetBuildInfoHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBuildInfoServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBuildInfoServiceHandler struct{}
+
+func (UnimplementedBuildInfoServiceHandler) GetBuildInfo(context.Context, *connect.Request[buildinfov1.GetBuildInfoRequest]) (*connect.Response[buildinfov1.GetBuildInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buildinfo.v1.BuildInfoService.GetBuildInfo is not implemented"))
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package connectbuildinfo provides a connect-go handler for the
+// BuildInfoService, which is defined in the same proto as the gRPC service of
+// package grpcbuildinfo.
+package connectbuildinfo
+
+//go:generate protoc -I ../grpcbuildinfo --connect-go_out=. --connect-go_opt=module=github.com/go-pogo/buildinfo/grpcbuildinfo/buildinfov1 buildinfov1/buildinfo.proto
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/go-pogo/buildinfo"
+	"github.com/go-pogo/buildinfo/connectbuildinfo/buildinfov1connect"
+	"github.com/go-pogo/buildinfo/grpcbuildinfo"
+	"github.com/go-pogo/buildinfo/grpcbuildinfo/buildinfov1"
+)
+
+var _ buildinfov1connect.BuildInfoServiceHandler = (*Service)(nil)
+
+// Service implements buildinfov1connect.BuildInfoServiceHandler and responds
+// with the build information of the BuildInfo it is created with.
+type Service struct {
+	srv *grpcbuildinfo.Server
+}
+
+// NewService creates a new Service for BuildInfo bld. Use
+// grpcbuildinfo.IncludeDeps and grpcbuildinfo.IncludeSettings to include
+// additional information in the response.
+func NewService(bld *buildinfo.BuildInfo, opts ...grpcbuildinfo.Option) *Service {
+	return &Service{srv: grpcbuildinfo.NewServer(bld, opts...)}
+}
+
+// GetBuildInfo returns the build information of the running service.
+func (s *Service) GetBuildInfo(ctx context.Context, req *connect.Request[buildinfov1.GetBuildInfoRequest]) (*connect.Response[buildinfov1.GetBuildInfoResponse], error) {
+	res, err := s.srv.GetBuildInfo(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// NewHandler returns the path on which to mount the handler for Service svc,
+// and the http.Handler itself. Use NewService to create a Service with
+// grpcbuildinfo.Option(s), e.g.
+//
+//	NewHandler(NewService(bld, grpcbuildinfo.IncludeDeps()))
+func NewHandler(svc *Service, opts ...connect.HandlerOption) (string, http.Handler) {
+	return buildinfov1connect.NewBuildInfoServiceHandler(svc, opts...)
+}
+
+// Register registers the http.Handler created with NewHandler to mux and
+// returns the path it is registered with.
+func Register(mux *http.ServeMux, svc *Service, opts ...connect.HandlerOption) string {
+	path, handler := NewHandler(svc, opts...)
+	mux.Handle(path, handler)
+	return path
+}
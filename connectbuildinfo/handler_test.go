@@ -0,0 +1,51 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package connectbuildinfo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/go-pogo/buildinfo"
+	"github.com/go-pogo/buildinfo/connectbuildinfo/buildinfov1connect"
+	"github.com/go-pogo/buildinfo/grpcbuildinfo"
+	"github.com/go-pogo/buildinfo/grpcbuildinfo/buildinfov1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	mux := http.NewServeMux()
+	path := Register(mux, NewService(
+		&buildinfo.BuildInfo{AltVersion: "v1.2.3"},
+		grpcbuildinfo.IncludeSettings(),
+	))
+	assert.Exactly(t, "/"+buildinfov1connect.BuildInfoServiceName+"/", path)
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	t.Run("client", func(t *testing.T) {
+		client := buildinfov1connect.NewBuildInfoServiceClient(srv.Client(), srv.URL)
+		have, err := client.GetBuildInfo(context.Background(),
+			connect.NewRequest(&buildinfov1.GetBuildInfoRequest{}),
+		)
+		assert.NoError(t, err)
+		assert.Exactly(t, "v1.2.3", have.Msg.GetBuildInfo().GetVersion())
+	})
+	t.Run("json", func(t *testing.T) {
+		res, err := srv.Client().Post(
+			srv.URL+buildinfov1connect.BuildInfoServiceGetBuildInfoProcedure,
+			"application/json",
+			strings.NewReader("{}"),
+		)
+		assert.NoError(t, err)
+		defer res.Body.Close()
+		assert.Exactly(t, http.StatusOK, res.StatusCode)
+	})
+}
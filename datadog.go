@@ -0,0 +1,38 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+// DatadogEnv returns the Datadog unified service tagging environment
+// variables as KEY=value assignments, e.g. DD_SERVICE=myapp and
+// DD_VERSION=v1.2.3. DD_GIT_COMMIT_SHA is added when BuildInfo bld contains a
+// revision. DD_SERVICE is omitted when service is empty. The result can be
+// appended to the Env of an exec.Cmd, or written to an env file.
+func DatadogEnv(service string, bld *BuildInfo) []string {
+	env := make([]string, 0, 3)
+	if service != "" {
+		env = append(env, "DD_SERVICE="+service)
+	}
+	env = append(env, "DD_VERSION="+bld.Version())
+	if rev := bld.Revision(); rev != "" {
+		env = append(env, "DD_GIT_COMMIT_SHA="+rev)
+	}
+	return env
+}
+
+// DatadogTags returns the Datadog unified service tags, e.g. service:myapp and
+// version:v1.2.3, for use with the Datadog statsd and tracer clients. The
+// git.commit.sha tag is added when BuildInfo bld contains a revision. The
+// service tag is omitted when service is empty.
+func DatadogTags(service string, bld *BuildInfo) []string {
+	tags := make([]string, 0, 3)
+	if service != "" {
+		tags = append(tags, "service:"+service)
+	}
+	tags = append(tags, "version:"+bld.Version())
+	if rev := bld.Revision(); rev != "" {
+		tags = append(tags, "git.commit.sha:"+rev)
+	}
+	return tags
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatadog(t *testing.T) {
+	tests := map[string]struct {
+		service  string
+		input    BuildInfo
+		wantEnv  []string
+		wantTags []string
+	}{
+		"version only": {
+			input:    BuildInfo{AltVersion: "v1.2.3"},
+			wantEnv:  []string{"DD_VERSION=v1.2.3"},
+			wantTags: []string{"version:v1.2.3"},
+		},
+		"all": {
+			service: "myapp",
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					Settings: []debug.BuildSetting{
+						{Key: keyRevision, Value: "fedcba"},
+					},
+				},
+				AltVersion: "v1.2.3",
+			},
+			wantEnv:  []string{"DD_SERVICE=myapp", "DD_VERSION=v1.2.3", "DD_GIT_COMMIT_SHA=fedcba"},
+			wantTags: []string{"service:myapp", "version:v1.2.3", "git.commit.sha:fedcba"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Exactly(t, tc.wantEnv, DatadogEnv(tc.service, &tc.input))
+			assert.Exactly(t, tc.wantTags, DatadogTags(tc.service, &tc.input))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"runtime/debug"
+	"sort"
+)
+
+// ModuleChange describes a module dependency which differs between two
+// builds. Old is empty when the module is added, New is empty when the module
+// is removed.
+type ModuleChange struct {
+	Path string
+	Old  string
+	New  string
+}
+
+// DiffDeps returns the module dependencies which are added, removed or have a
+// different version in BuildInfo b compared to BuildInfo a, sorted by module
+// path. A replaced module's version is the version of its replacement, or the
+// replacement's path when it is a local directory. Use it together with
+// ReadFile to compare the dependencies of two binaries.
+func DiffDeps(a, b *BuildInfo) []ModuleChange {
+	am, bm := depVersions(a), depVersions(b)
+
+	var changes []ModuleChange
+	for path, old := range am {
+		if nw := bm[path]; nw != old {
+			changes = append(changes, ModuleChange{Path: path, Old: old, New: nw})
+		}
+	}
+	for path, nw := range bm {
+		if _, ok := am[path]; !ok {
+			changes = append(changes, ModuleChange{Path: path, New: nw})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+func depVersions(bld *BuildInfo) map[string]string {
+	if !bld.init() {
+		return nil
+	}
+
+	m := make(map[string]string, len(bld.info.Deps))
+	for _, dep := range bld.info.Deps {
+		m[dep.Path] = depVersion(dep)
+	}
+	return m
+}
+
+func depVersion(mod *debug.Module) string {
+	if mod.Replace == nil {
+		return mod.Version
+	}
+	if mod.Replace.Version != "" {
+		return mod.Replace.Version
+	}
+	return mod.Replace.Path
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffDeps(t *testing.T) {
+	a := BuildInfo{info: &debug.BuildInfo{Deps: []*debug.Module{
+		{Path: "example.com/changed", Version: "v1.0.0"},
+		{Path: "example.com/removed", Version: "v1.0.0"},
+		{Path: "example.com/same", Version: "v1.0.0"},
+	}}}
+	b := BuildInfo{info: &debug.BuildInfo{Deps: []*debug.Module{
+		{Path: "example.com/added", Version: "v0.1.0"},
+		{Path: "example.com/changed", Version: "v1.1.0"},
+		{Path: "example.com/same", Version: "v1.0.0"},
+		{Path: "example.com/replaced", Version: "v1.0.0", Replace: &debug.Module{Path: "../replaced"}},
+	}}}
+
+	assert.Exactly(t, []ModuleChange{
+		{Path: "example.com/added", New: "v0.1.0"},
+		{Path: "example.com/changed", Old: "v1.0.0", New: "v1.1.0"},
+		{Path: "example.com/removed", Old: "v1.0.0"},
+		{Path: "example.com/replaced", New: "../replaced"},
+	}, DiffDeps(&a, &b))
+	assert.Empty(t, DiffDeps(&a, &a))
+}
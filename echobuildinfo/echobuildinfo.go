@@ -0,0 +1,46 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package echobuildinfo provides a route handler and middleware for the Echo
+// web framework, which behave identical to their net/http counterparts in
+// package buildinfo.
+package echobuildinfo
+
+import (
+	"github.com/go-pogo/buildinfo"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler returns an echo.HandlerFunc which writes BuildInfo bld as a JSON
+// response. See buildinfo.NewHandler for details.
+func Handler(bld *buildinfo.BuildInfo, opts ...buildinfo.HandlerOption) echo.HandlerFunc {
+	return echo.WrapHandler(buildinfo.NewHandler(bld, opts...))
+}
+
+// Middleware returns an echo.MiddlewareFunc which sets the
+// buildinfo.HeaderVersion and buildinfo.HeaderRevision headers on every
+// response. See buildinfo.Middleware for details.
+func Middleware(bld *buildinfo.BuildInfo) echo.MiddlewareFunc {
+	return echo.WrapMiddleware(buildinfo.Middleware(bld))
+}
+
+// Router is implemented by both echo.Echo and echo.Group.
+type Router interface {
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+var (
+	_ Router = (*echo.Echo)(nil)
+	_ Router = (*echo.Group)(nil)
+)
+
+// Register registers the echo.HandlerFunc created with Handler for GET and
+// HEAD requests to Router r at buildinfo.PathPattern. It returns the route of
+// the GET request.
+func Register(r Router, bld *buildinfo.BuildInfo, opts ...buildinfo.HandlerOption) *echo.Route {
+	h := Handler(bld, opts...)
+	r.HEAD(buildinfo.PathPattern, h)
+	return r.GET(buildinfo.PathPattern, h)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package echobuildinfo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+	want, _ := bld.MarshalJSON()
+
+	e := echo.New()
+	route := Register(e, &bld)
+	assert.Exactly(t, http.MethodGet, route.Method)
+	assert.Exactly(t, buildinfo.PathPattern, route.Path)
+
+	t.Run("get", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, buildinfo.PathPattern, nil))
+
+		assert.Exactly(t, http.StatusOK, rec.Code)
+		assert.Exactly(t, "application/json", rec.Header().Get(echo.HeaderContentType))
+		assert.Exactly(t, string(want), rec.Body.String())
+	})
+	t.Run("head", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodHead, buildinfo.PathPattern, nil))
+
+		assert.Exactly(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.Bytes())
+	})
+	t.Run("not modified", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, buildinfo.PathPattern, nil))
+
+		req := httptest.NewRequest(http.MethodGet, buildinfo.PathPattern, nil)
+		req.Header.Set("If-None-Match", rec.Header().Get("ETag"))
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Exactly(t, http.StatusNotModified, rec.Code)
+	})
+	t.Run("post", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, buildinfo.PathPattern, nil))
+
+		assert.Exactly(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(Middleware(&buildinfo.BuildInfo{AltVersion: "v1.2.3"}))
+	e.GET("/", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Exactly(t, http.StatusNoContent, rec.Code)
+	assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+}
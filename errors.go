@@ -0,0 +1,79 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-pogo/errors"
+)
+
+// BuildError is an error which is annotated with the version and revision of
+// the build it occurred in. It is created with WithBuild.
+type BuildError struct {
+	error
+	Version  string
+	Revision string
+}
+
+// WithBuild annotates err with the version and revision of BuildInfo bld. The
+// error message is left untouched, the build is printed when formatting the
+// error with %+v. It returns nil when err is nil, and err as is when it
+// already contains a BuildError.
+func WithBuild(err error, bld *BuildInfo) error {
+	if err == nil {
+		return nil
+	}
+
+	var be *BuildError
+	if errors.As(err, &be) {
+		return err
+	}
+
+	return &BuildError{
+		error:    err,
+		Version:  bld.Version(),
+		Revision: bld.Revision(),
+	}
+}
+
+// BuildOf returns the version and revision of the first BuildError in the
+// error chain of err, and whether such an error is found.
+func BuildOf(err error) (version, revision string, ok bool) {
+	var be *BuildError
+	if !errors.As(err, &be) {
+		return "", "", false
+	}
+	return be.Version, be.Revision, true
+}
+
+// Unembed the underlying error.
+func (e *BuildError) Unembed() error { return e.error }
+
+// Unwrap the underlying error.
+func (e *BuildError) Unwrap() error { return e.error }
+
+// StackTrace returns the errors.StackTrace of the underlying error, if any.
+func (e *BuildError) StackTrace() *errors.StackTrace {
+	return errors.GetStackTrace(e.error)
+}
+
+// Format formats the error according to fmt.State s and verb v. The %+v verb
+// prints the detailed underlying error followed by the build.
+func (e *BuildError) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('+') {
+		_, _ = fmt.Fprintf(s, "%+v\nbuild: %s", e.error, e.build())
+		return
+	}
+	_, _ = io.WriteString(s, e.Error())
+}
+
+func (e *BuildError) build() string {
+	if e.Revision == "" {
+		return e.Version
+	}
+	return e.Version + " " + e.Revision
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"fmt"
+	"runtime/debug"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithBuild(t *testing.T) {
+	bld := BuildInfo{
+		info: &debug.BuildInfo{
+			Settings: []debug.BuildSetting{
+				{Key: keyRevision, Value: "fedcba"},
+			},
+		},
+		AltVersion: "v1.2.3",
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, WithBuild(nil, &bld))
+	})
+	t.Run("error", func(t *testing.T) {
+		orig := errors.New("some error")
+		err := WithBuild(orig, &bld)
+
+		assert.ErrorIs(t, err, orig)
+		assert.Exactly(t, "some error", err.Error())
+		assert.Exactly(t, "some error", fmt.Sprintf("%v", err))
+		assert.Contains(t, fmt.Sprintf("%+v", err), "\nbuild: v1.2.3 fedcba")
+
+		ver, rev, ok := BuildOf(errors.Wrap(err, "wrapped"))
+		assert.True(t, ok)
+		assert.Exactly(t, "v1.2.3", ver)
+		assert.Exactly(t, "fedcba", rev)
+	})
+	t.Run("already annotated", func(t *testing.T) {
+		err := WithBuild(errors.New("some error"), &bld)
+		assert.Same(t, err, WithBuild(err, &BuildInfo{AltVersion: "v0.0.1"}))
+	})
+	t.Run("not annotated", func(t *testing.T) {
+		_, _, ok := BuildOf(errors.New("some error"))
+		assert.False(t, ok)
+	})
+}
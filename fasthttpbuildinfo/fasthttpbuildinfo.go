@@ -0,0 +1,35 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fasthttpbuildinfo provides fasthttp.RequestHandler(s) which serve
+// the build information for applications that use fasthttp instead of
+// net/http.
+package fasthttpbuildinfo
+
+import (
+	"github.com/go-pogo/buildinfo"
+	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
+)
+
+// Handler returns a fasthttp.RequestHandler which writes BuildInfo bld as a
+// JSON response. It behaves identical to the http.Handler created with
+// buildinfo.NewHandler and HandlerOption(s) opts.
+func Handler(bld *buildinfo.BuildInfo, opts ...buildinfo.HandlerOption) fasthttp.RequestHandler {
+	return fasthttpadaptor.NewFastHTTPHandler(buildinfo.NewHandler(bld, opts...))
+}
+
+// TextHandler returns a fasthttp.RequestHandler which writes the string
+// representation of BuildInfo bld as a plain text response. See
+// buildinfo.BuildInfo.String for details about the format.
+func TextHandler(bld *buildinfo.BuildInfo) fasthttp.RequestHandler {
+	body := []byte(bld.String())
+	return func(ctx *fasthttp.RequestCtx) {
+		ctx.SetContentType("text/plain; charset=utf-8")
+		if ctx.IsHead() {
+			ctx.Response.SkipBody = true
+		}
+		ctx.SetBody(body)
+	}
+}
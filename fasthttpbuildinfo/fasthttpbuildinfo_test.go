@@ -0,0 +1,61 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fasthttpbuildinfo
+
+import (
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func request(h fasthttp.RequestHandler, method string) *fasthttp.RequestCtx {
+	var ctx fasthttp.RequestCtx
+	ctx.Request.Header.SetMethod(method)
+	ctx.Request.SetRequestURI(buildinfo.PathPattern)
+	h(&ctx)
+	return &ctx
+}
+
+func TestHandler(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+	want, _ := bld.MarshalJSON()
+
+	t.Run("get", func(t *testing.T) {
+		ctx := request(Handler(&bld), fasthttp.MethodGet)
+		assert.Exactly(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+		assert.Exactly(t, "application/json", string(ctx.Response.Header.ContentType()))
+		assert.Exactly(t, string(want), string(ctx.Response.Body()))
+	})
+	t.Run("not modified", func(t *testing.T) {
+		h := Handler(&bld)
+		etag := request(h, fasthttp.MethodGet).Response.Header.Peek("ETag")
+
+		var ctx fasthttp.RequestCtx
+		ctx.Request.Header.SetMethod(fasthttp.MethodGet)
+		ctx.Request.Header.SetBytesV("If-None-Match", etag)
+		ctx.Request.SetRequestURI(buildinfo.PathPattern)
+		h(&ctx)
+
+		assert.Exactly(t, fasthttp.StatusNotModified, ctx.Response.StatusCode())
+	})
+}
+
+func TestTextHandler(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+
+	t.Run("get", func(t *testing.T) {
+		ctx := request(TextHandler(&bld), fasthttp.MethodGet)
+		assert.Exactly(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+		assert.Exactly(t, "text/plain; charset=utf-8", string(ctx.Response.Header.ContentType()))
+		assert.Exactly(t, bld.String(), string(ctx.Response.Body()))
+	})
+	t.Run("head", func(t *testing.T) {
+		ctx := request(TextHandler(&bld), fasthttp.MethodHead)
+		assert.Exactly(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+		assert.True(t, ctx.Response.SkipBody)
+	})
+}
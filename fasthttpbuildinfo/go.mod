@@ -0,0 +1,23 @@
+module github.com/go-pogo/buildinfo/fasthttpbuildinfo
+
+go 1.25.0
+
+require (
+	github.com/go-pogo/buildinfo v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.10.0
+	github.com/valyala/fasthttp v1.74.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/go-pogo/errors v0.11.2 // indirect
+	github.com/klauspost/compress v1.20.0 // indirect
+	github.com/kr/pretty v0.3.1 // indirect
+	github.com/molecule-man/go-brrr v1.0.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/go-pogo/buildinfo => ../
@@ -0,0 +1,34 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fiberbuildinfo provides fiber.Handler(s) which serve the build
+// information for applications that use the Fiber web framework.
+package fiberbuildinfo
+
+import (
+	"github.com/go-pogo/buildinfo"
+	"github.com/go-pogo/buildinfo/fasthttpbuildinfo"
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+)
+
+// Handler returns a fiber.Handler which writes BuildInfo bld as a JSON
+// response. See fasthttpbuildinfo.Handler for details.
+func Handler(bld *buildinfo.BuildInfo, opts ...buildinfo.HandlerOption) fiber.Handler {
+	return adapt(fasthttpbuildinfo.Handler(bld, opts...))
+}
+
+// TextHandler returns a fiber.Handler which writes the string representation
+// of BuildInfo bld as a plain text response. See
+// fasthttpbuildinfo.TextHandler for details.
+func TextHandler(bld *buildinfo.BuildInfo) fiber.Handler {
+	return adapt(fasthttpbuildinfo.TextHandler(bld))
+}
+
+func adapt(h fasthttp.RequestHandler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		h(c.Context())
+		return nil
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fiberbuildinfo
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+	want, _ := bld.MarshalJSON()
+
+	app := fiber.New()
+	app.Get(buildinfo.PathPattern, Handler(&bld))
+	app.Get("/version.txt", TextHandler(&bld))
+
+	tests := map[string]struct {
+		target          string
+		wantContentType string
+		wantBody        string
+	}{
+		"json": {
+			target:          buildinfo.PathPattern,
+			wantContentType: "application/json",
+			wantBody:        string(want),
+		},
+		"text": {
+			target:          "/version.txt",
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        bld.String(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := app.Test(httptest.NewRequest(http.MethodGet, tc.target, nil))
+			assert.NoError(t, err)
+			defer res.Body.Close()
+
+			body, err := io.ReadAll(res.Body)
+			assert.NoError(t, err)
+			assert.Exactly(t, http.StatusOK, res.StatusCode)
+			assert.Exactly(t, tc.wantContentType, res.Header.Get("Content-Type"))
+			assert.Exactly(t, tc.wantBody, string(body))
+		})
+	}
+}
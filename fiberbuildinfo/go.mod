@@ -0,0 +1,34 @@
+module github.com/go-pogo/buildinfo/fiberbuildinfo
+
+go 1.25.0
+
+require (
+	github.com/go-pogo/buildinfo v0.0.0-00010101000000-000000000000
+	github.com/go-pogo/buildinfo/fasthttpbuildinfo v0.0.0-00010101000000-000000000000
+	github.com/gofiber/fiber/v2 v2.52.15
+	github.com/stretchr/testify v1.10.0
+	github.com/valyala/fasthttp v1.74.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/go-pogo/errors v0.11.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/klauspost/compress v1.20.0 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/mattn/go-runewidth v0.0.16 // indirect
+	github.com/molecule-man/go-brrr v1.0.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rivo/uniseg v0.2.0 // indirect
+	github.com/rogpeppe/go-internal v1.9.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace (
+	github.com/go-pogo/buildinfo => ../
+	github.com/go-pogo/buildinfo/fasthttpbuildinfo => ../fasthttpbuildinfo
+)
@@ -4,7 +4,6 @@ go 1.20
 
 require (
 	github.com/go-pogo/errors v0.11.2
-	github.com/go-pogo/writing v0.2.1
 	github.com/stretchr/testify v1.10.0
 )
 
@@ -0,0 +1,132 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package graphqlbuildinfo provides a resolver which exposes the build
+information in an existing GraphQL schema. It does not depend on any GraphQL
+library and works with schema-first libraries like graph-gophers/graphql-go
+and gqlgen.
+
+Add Schema to your own schema and return a BuildInfoResolver from a query
+field:
+
+	type Query {
+	    buildInfo: BuildInfo!
+	}
+
+With graph-gophers/graphql-go, the root resolver's method looks like:
+
+	func (r *RootResolver) BuildInfo() *graphqlbuildinfo.BuildInfoResolver {
+	    return graphqlbuildinfo.NewResolver(bld)
+	}
+
+With gqlgen, bind the types in gqlgen.yml:
+
+	models:
+	  BuildInfo:
+	    model: github.com/go-pogo/buildinfo/graphqlbuildinfo.BuildInfoResolver
+	  Module:
+	    model: github.com/go-pogo/buildinfo/graphqlbuildinfo.ModuleResolver
+*/
+package graphqlbuildinfo
+
+import (
+	_ "embed"
+	"runtime/debug"
+	"time"
+
+	"github.com/go-pogo/buildinfo"
+)
+
+// Schema contains the GraphQL type definitions of BuildInfo and Module, which
+// are resolved by BuildInfoResolver and ModuleResolver.
+//
+//go:embed schema.graphql
+var Schema string
+
+// BuildInfoResolver resolves the fields of the BuildInfo GraphQL type.
+type BuildInfoResolver struct {
+	bld *buildinfo.BuildInfo
+}
+
+// NewResolver creates a new BuildInfoResolver for BuildInfo bld. Module
+// dependencies are only resolved when bld is created with buildinfo.New.
+func NewResolver(bld *buildinfo.BuildInfo) *BuildInfoResolver {
+	return &BuildInfoResolver{bld: bld}
+}
+
+// Version resolves the version field.
+func (r *BuildInfoResolver) Version() string { return r.bld.Version() }
+
+// Revision resolves the revision field. It returns nil when the revision is
+// unknown.
+func (r *BuildInfoResolver) Revision() *string { return nonEmpty(r.bld.Revision()) }
+
+// Time resolves the time field as a RFC 3339 formatted string. It returns nil
+// when the time is unknown.
+func (r *BuildInfoResolver) Time() *string {
+	t := r.bld.Time()
+	if t.IsZero() {
+		return nil
+	}
+
+	s := t.Format(time.RFC3339)
+	return &s
+}
+
+// GoVersion resolves the goVersion field.
+func (r *BuildInfoResolver) GoVersion() string { return r.bld.GoVersion() }
+
+// BuildNumber resolves the buildNumber field. It returns nil when the build
+// number is unknown.
+func (r *BuildInfoResolver) BuildNumber() *string { return nonEmpty(r.bld.BuildNumber) }
+
+// RepoUrl resolves the repoUrl field. It returns nil when the repository url
+// is unknown.
+func (r *BuildInfoResolver) RepoUrl() *string { return nonEmpty(r.bld.RepoURL) }
+
+// Deps resolves the deps field.
+func (r *BuildInfoResolver) Deps() []*ModuleResolver {
+	info := r.bld.Internal()
+	if info == nil {
+		return []*ModuleResolver{}
+	}
+
+	res := make([]*ModuleResolver, 0, len(info.Deps))
+	for _, dep := range info.Deps {
+		res = append(res, newModuleResolver(dep))
+	}
+	return res
+}
+
+// ModuleResolver resolves the fields of the Module GraphQL type.
+type ModuleResolver struct {
+	mod *debug.Module
+}
+
+func newModuleResolver(mod *debug.Module) *ModuleResolver {
+	if mod == nil {
+		return nil
+	}
+	return &ModuleResolver{mod: mod}
+}
+
+// Path resolves the path field.
+func (r *ModuleResolver) Path() string { return r.mod.Path }
+
+// Version resolves the version field.
+func (r *ModuleResolver) Version() *string { return nonEmpty(r.mod.Version) }
+
+// Sum resolves the sum field.
+func (r *ModuleResolver) Sum() *string { return nonEmpty(r.mod.Sum) }
+
+// Replace resolves the replace field.
+func (r *ModuleResolver) Replace() *ModuleResolver { return newModuleResolver(r.mod.Replace) }
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
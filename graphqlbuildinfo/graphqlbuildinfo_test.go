@@ -0,0 +1,65 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphqlbuildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildInfoResolver(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		res := NewResolver(&buildinfo.BuildInfo{AltVersion: "v1.2.3"})
+		assert.Exactly(t, "v1.2.3", res.Version())
+		assert.Exactly(t, runtime.Version(), res.GoVersion())
+		assert.Nil(t, res.Time())
+		assert.Nil(t, res.BuildNumber())
+		assert.Nil(t, res.RepoUrl())
+		assert.NotNil(t, res.Deps())
+	})
+	t.Run("build number and repo url", func(t *testing.T) {
+		res := NewResolver(&buildinfo.BuildInfo{
+			AltVersion:  "v1.2.3",
+			BuildNumber: "42",
+			RepoURL:     "https://github.com/go-pogo/buildinfo",
+		})
+		assert.Exactly(t, "42", *res.BuildNumber())
+		assert.Exactly(t, "https://github.com/go-pogo/buildinfo", *res.RepoUrl())
+	})
+	t.Run("new", func(t *testing.T) {
+		bld, err := buildinfo.New("v1.2.3")
+		if err != nil {
+			t.Skip(err)
+		}
+
+		res := NewResolver(bld)
+		assert.Exactly(t, "v1.2.3", res.Version())
+		assert.Len(t, res.Deps(), len(bld.Internal().Deps))
+	})
+}
+
+func TestModuleResolver(t *testing.T) {
+	res := newModuleResolver(&debug.Module{
+		Path:    "github.com/go-pogo/buildinfo",
+		Version: "v1.0.0",
+		Replace: &debug.Module{Path: "../buildinfo"},
+	})
+
+	assert.Exactly(t, "github.com/go-pogo/buildinfo", res.Path())
+	assert.Exactly(t, "v1.0.0", *res.Version())
+	assert.Nil(t, res.Sum())
+	assert.Exactly(t, "../buildinfo", res.Replace().Path())
+	assert.Nil(t, res.Replace().Version())
+	assert.Nil(t, res.Replace().Replace())
+}
+
+func TestSchema(t *testing.T) {
+	assert.Contains(t, Schema, "type BuildInfo {")
+	assert.Contains(t, Schema, "type Module {")
+}
@@ -0,0 +1,378 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: buildinfov1/buildinfo.proto
+
+package buildinfov1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetBuildInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBuildInfoRequest) Reset() {
+	*x = GetBuildInfoRequest{}
+	mi := &file_buildinfov1_buildinfo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBuildInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildInfoRequest) ProtoMessage() {}
+
+func (x *GetBuildInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buildinfov1_buildinfo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetBuildInfoRequest) Descriptor() ([]byte, []int) {
+	return file_buildinfov1_buildinfo_proto_rawDescGZIP(), []int{0}
+}
+
+type GetBuildInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuildInfo     *BuildInfo             `protobuf:"bytes,1,opt,name=build_info,json=buildInfo,proto3" json:"build_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBuildInfoResponse) Reset() {
+	*x = GetBuildInfoResponse{}
+	mi := &file_buildinfov1_buildinfo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBuildInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildInfoResponse) ProtoMessage() {}
+
+func (x *GetBuildInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buildinfov1_buildinfo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetBuildInfoResponse) Descriptor() ([]byte, []int) {
+	return file_buildinfov1_buildinfo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetBuildInfoResponse) GetBuildInfo() *BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+// BuildInfo contains the relevant information of the current release's build
+// version, revision and time.
+type BuildInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the release.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Revision is the (short) commit hash the release is build from.
+	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Time of the commit the release was build.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// GoVersion is the Go runtime version used to make the build.
+	GoVersion string `protobuf:"bytes,4,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	// Settings are the build settings used to make the build. They are only
+	// included when the server is configured to do so.
+	Settings map[string]string `protobuf:"bytes,5,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Deps are the module dependencies of the build. They are only included
+	// when the server is configured to do so.
+	Deps []*Module `protobuf:"bytes,6,rep,name=deps,proto3" json:"deps,omitempty"`
+	// BuildNumber is the number of the CI build which produced the release.
+	BuildNumber string `protobuf:"bytes,7,opt,name=build_number,json=buildNumber,proto3" json:"build_number,omitempty"`
+	// RepoUrl is the url of the source repository the release is build from.
+	RepoUrl       string `protobuf:"bytes,8,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	mi := &file_buildinfov1_buildinfo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_buildinfov1_buildinfo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_buildinfov1_buildinfo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BuildInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetSettings() map[string]string {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetDeps() []*Module {
+	if x != nil {
+		return x.Deps
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetBuildNumber() string {
+	if x != nil {
+		return x.BuildNumber
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+// Module describes a single module included in a build.
+type Module struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Sum           string                 `protobuf:"bytes,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Replace       *Module                `protobuf:"bytes,4,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	mi := &file_buildinfov1_buildinfo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Module) ProtoMessage() {}
+
+func (x *Module) ProtoReflect() protoreflect.Message {
+	mi := &file_buildinfov1_buildinfo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_buildinfov1_buildinfo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Module) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Module) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Module) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+func (x *Module) GetReplace() *Module {
+	if x != nil {
+		return x.Replace
+	}
+	return nil
+}
+
+var File_buildinfov1_buildinfo_proto protoreflect.FileDescriptor
+
+const file_buildinfov1_buildinfo_proto_rawDesc = "" +
+	"\n" +
+	"\x1bbuildinfov1/buildinfo.proto\x12\fbuildinfo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x15\n" +
+	"\x13GetBuildInfoRequest\"N\n" +
+	"\x14GetBuildInfoResponse\x126\n" +
+	"\n" +
+	"build_info\x18\x01 \x01(\v2\x17.buildinfo.v1.BuildInfoR\tbuildInfo\"\xf8\x02\n" +
+	"\tBuildInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\tR\brevision\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1d\n" +
+	"\n" +
+	"go_version\x18\x04 \x01(\tR\tgoVersion\x12A\n" +
+	"\bsettings\x18\x05 \x03(\v2%.buildinfo.v1.BuildInfo.SettingsEntryR\bsettings\x12(\n" +
+	"\x04deps\x18\x06 \x03(\v2\x14.buildinfo.v1.ModuleR\x04deps\x12!\n" +
+	"\fbuild_number\x18\a \x01(\tR\vbuildNumber\x12\x19\n" +
+	"\brepo_url\x18\b \x01(\tR\arepoUrl\x1a;\n" +
+	"\rSettingsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"x\n" +
+	"\x06Module\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x10\n" +
+	"\x03sum\x18\x03 \x01(\tR\x03sum\x12.\n" +
+	"\areplace\x18\x04 \x01(\v2\x14.buildinfo.v1.ModuleR\areplace2i\n" +
+	"\x10BuildInfoService\x12U\n" +
+	"\fGetBuildInfo\x12!.buildinfo.v1.GetBuildInfoRequest\x1a\".buildinfo.v1.GetBuildInfoResponseBDZBgithub.com/go-pogo/buildinfo/grpcbuildinfo/buildinfov1;buildinfov1b\x06proto3"
+
+var (
+	file_buildinfov1_buildinfo_proto_rawDescOnce sync.Once
+	file_buildinfov1_buildinfo_proto_rawDescData []byte
+)
+
+func file_buildinfov1_buildinfo_proto_rawDescGZIP() []byte {
+	file_buildinfov1_buildinfo_proto_rawDescOnce.Do(func() {
+		file_buildinfov1_buildinfo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_buildinfov1_buildinfo_proto_rawDesc), len(file_buildinfov1_buildinfo_proto_rawDesc)))
+	})
+	return file_buildinfov1_buildinfo_proto_rawDescData
+}
+
+var file_buildinfov1_buildinfo_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_buildinfov1_buildinfo_proto_goTypes = []any{
+	(*GetBuildInfoRequest)(nil),   // 0: buildinfo.v1.GetBuildInfoRequest
+	(*GetBuildInfoResponse)(nil),  // 1: buildinfo.v1.GetBuildInfoResponse
+	(*BuildInfo)(nil),             // 2: buildinfo.v1.BuildInfo
+	(*Module)(nil),                // 3: buildinfo.v1.Module
+	nil,                           // 4: buildinfo.v1.BuildInfo.SettingsEntry
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_buildinfov1_buildinfo_proto_depIdxs = []int32{
+	2, // 0: buildinfo.v1.GetBuildInfoResponse.build_info:type_name -> buildinfo.v1.BuildInfo
+	5, // 1: buildinfo.v1.BuildInfo.time:type_name -> google.protobuf.Timestamp
+	4, // 2: buildinfo.v1.BuildInfo.settings:type_name -> buildinfo.v1.BuildInfo.SettingsEntry
+	3, // 3: buildinfo.v1.BuildInfo.deps:type_name -> buildinfo.v1.Module
+	3, // 4: buildinfo.v1.Module.replace:type_name -> buildinfo.v1.Module
+	0, // 5: buildinfo.v1.BuildInfoService.GetBuildInfo:input_type -> buildinfo.v1.GetBuildInfoRequest
+	1, // 6: buildinfo.v1.BuildInfoService.GetBuildInfo:output_type -> buildinfo.v1.GetBuildInfoResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_buildinfov1_buildinfo_proto_init() }
+func file_buildinfov1_buildinfo_proto_init() {
+	if File_buildinfov1_buildinfo_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_buildinfov1_buildinfo_proto_rawDesc), len(file_buildinfov1_buildinfo_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_buildinfov1_buildinfo_proto_goTypes,
+		DependencyIndexes: file_buildinfov1_buildinfo_proto_depIdxs,
+		MessageInfos:      file_buildinfov1_buildinfo_proto_msgTypes,
+	}.Build()
+	File_buildinfov1_buildinfo_proto = out.File
+	file_buildinfov1_buildinfo_proto_goTypes = nil
+	file_buildinfov1_buildinfo_proto_depIdxs = nil
+}
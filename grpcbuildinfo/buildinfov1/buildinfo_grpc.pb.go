@@ -0,0 +1,131 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: buildinfov1/buildinfo.proto
+
+package buildinfov1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BuildInfoService_GetBuildInfo_FullMethodName = "/buildinfo.v1.BuildInfoService/GetBuildInfo"
+)
+
+// BuildInfoServiceClient is the client API for BuildInfoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BuildInfoService provides the build information of the running service.
+type BuildInfoServiceClient interface {
+	// GetBuildInfo returns the build information of the running service.
+	GetBuildInfo(ctx context.Context, in *GetBuildInfoRequest, opts ...grpc.CallOption) (*GetBuildInfoResponse, error)
+}
+
+type buildInfoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBuildInfoServiceClient(cc grpc.ClientConnInterface) BuildInfoServiceClient {
+	return &buildInfoServiceClient{cc}
+}
+
+func (c *buildInfoServiceClient) GetBuildInfo(ctx context.Context, in *GetBuildInfoRequest, opts ...grpc.CallOption) (*GetBuildInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBuildInfoResponse)
+	err := c.cc.Invoke(ctx, BuildInfoService_GetBuildInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BuildInfoServiceServer is the server API for BuildInfoService service.
+// All implementations must embed UnimplementedBuildInfoServiceServer
+// for forward compatibility.
+//
+// BuildInfoService provides the build information of the running service.
+type BuildInfoServiceServer interface {
+	// GetBuildInfo returns the build information of the running service.
+	GetBuildInfo(context.Context, *GetBuildInfoRequest) (*GetBuildInfoResponse, error)
+	mustEmbedUnimplementedBuildInfoServiceServer()
+}
+
+// UnimplementedBuildInfoServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBuildInfoServiceServer struct{}
+
+func (UnimplementedBuildInfoServiceServer) GetBuildInfo(context.Context, *GetBuildInfoRequest) (*GetBuildInfoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBuildInfo not implemented")
+}
+func (UnimplementedBuildInfoServiceServer) mustEmbedUnimplementedBuildInfoServiceServer() {}
+func (UnimplementedBuildInfoServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeBuildInfoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BuildInfoServiceServer will
+// result in compilation errors.
+type UnsafeBuildInfoServiceServer interface {
+	mustEmbedUnimplementedBuildInfoServiceServer()
+}
+
+func RegisterBuildInfoServiceServer(s grpc.ServiceRegistrar, srv BuildInfoServiceServer) {
+	// If the following call panics, it indicates UnimplementedBuildInfoServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BuildInfoService_ServiceDesc, srv)
+}
+
+func _BuildInfoService_GetBuildInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuildInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuildInfoServiceServer).GetBuildInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BuildInfoService_GetBuildInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuildInfoServiceServer).GetBuildInfo(ctx, req.(*GetBuildInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BuildInfoService_ServiceDesc is the grpc.ServiceDesc for BuildInfoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BuildInfoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buildinfo.v1.BuildInfoService",
+	HandlerType: (*BuildInfoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBuildInfo",
+			Handler:    _BuildInfoService_GetBuildInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "buildinfov1/buildinfo.proto",
+}
@@ -0,0 +1,25 @@
+module github.com/go-pogo/buildinfo/grpcbuildinfo
+
+go 1.25.0
+
+require (
+	github.com/go-pogo/buildinfo v0.0.0-00010101000000-000000000000
+	github.com/go-pogo/errors v0.11.2
+	github.com/stretchr/testify v1.10.0
+	google.golang.org/grpc v1.84.0
+	google.golang.org/protobuf v1.36.12
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/kr/pretty v0.3.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.57.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+	golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260706201446-f0a921348800 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/go-pogo/buildinfo => ../
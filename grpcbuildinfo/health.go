@@ -0,0 +1,77 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package grpcbuildinfo
+
+import (
+	"context"
+
+	"github.com/go-pogo/buildinfo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_health_v1.HealthServer = (*HealthServer)(nil)
+
+// HealthServer is a grpc_health_v1.HealthServer which attaches the version
+// and revision of the build to the header metadata of each Check response.
+// The serving status is determined by a buildinfo.ReadinessFunc and is the
+// same for the server as a whole and every known service. Watch and List are
+// not implemented.
+type HealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	md       metadata.MD
+	ready    buildinfo.ReadinessFunc
+	services map[string]struct{}
+}
+
+// NewHealthServer creates a new HealthServer for BuildInfo bld. A nil
+// buildinfo.ReadinessFunc ready always reports the service as serving. Next to
+// the empty service name, which represents the server as a whole, only the
+// provided services are known to the HealthServer.
+func NewHealthServer(bld *buildinfo.BuildInfo, ready buildinfo.ReadinessFunc, services ...string) *HealthServer {
+	srv := &HealthServer{
+		md:       Metadata(bld),
+		ready:    ready,
+		services: make(map[string]struct{}, len(services)),
+	}
+	for _, name := range services {
+		srv.services[name] = struct{}{}
+	}
+	return srv
+}
+
+// RegisterHealth creates a new HealthServer with NewHealthServer and
+// registers it to grpc.ServiceRegistrar reg.
+func RegisterHealth(reg grpc.ServiceRegistrar, bld *buildinfo.BuildInfo, ready buildinfo.ReadinessFunc, services ...string) *HealthServer {
+	srv := NewHealthServer(bld, ready, services...)
+	grpc_health_v1.RegisterHealthServer(reg, srv)
+	return srv
+}
+
+// Check returns the serving status as reported by the server's
+// buildinfo.ReadinessFunc. A NotFound error is returned when the requested
+// service is not known to the server.
+func (s *HealthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if name := req.GetService(); name != "" {
+		if _, ok := s.services[name]; !ok {
+			return nil, status.Error(codes.NotFound, "unknown service")
+		}
+	}
+
+	// only fails when not called from within a grpc server, which is of no
+	// concern to the health status
+	_ = grpc.SetHeader(ctx, s.md)
+
+	res := grpc_health_v1.HealthCheckResponse{
+		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+	}
+	if s.ready != nil && s.ready(ctx) != nil {
+		res.Status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return &res, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package grpcbuildinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRegisterHealth(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+
+	tests := map[string]struct {
+		ready buildinfo.ReadinessFunc
+		want  grpc_health_v1.HealthCheckResponse_ServingStatus
+	}{
+		"nil": {
+			want: grpc_health_v1.HealthCheckResponse_SERVING,
+		},
+		"ready": {
+			ready: func(context.Context) error { return nil },
+			want:  grpc_health_v1.HealthCheckResponse_SERVING,
+		},
+		"not ready": {
+			ready: func(context.Context) error { return errors.New("not ready") },
+			want:  grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := grpc.NewServer()
+			RegisterHealth(srv, &bld, tc.ready, "myapp.Service")
+
+			var header metadata.MD
+			have, err := grpc_health_v1.NewHealthClient(serve(t, srv)).
+				Check(context.Background(), &grpc_health_v1.HealthCheckRequest{}, grpc.Header(&header))
+
+			assert.NoError(t, err)
+			assert.Exactly(t, tc.want, have.GetStatus())
+			assert.Exactly(t, []string{"v1.2.3"}, header.Get(MetadataVersion))
+		})
+	}
+
+	t.Run("service", func(t *testing.T) {
+		tests := map[string]codes.Code{
+			"myapp.Service": codes.OK,
+			"other.Service": codes.NotFound,
+		}
+		for name, want := range tests {
+			t.Run(name, func(t *testing.T) {
+				srv := grpc.NewServer()
+				RegisterHealth(srv, &bld, nil, "myapp.Service")
+
+				_, err := grpc_health_v1.NewHealthClient(serve(t, srv)).
+					Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: name})
+				assert.Exactly(t, want, status.Code(err))
+			})
+		}
+	})
+}
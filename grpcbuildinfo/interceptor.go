@@ -0,0 +1,112 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package grpcbuildinfo
+
+import (
+	"context"
+
+	"github.com/go-pogo/buildinfo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// MetadataVersion is the metadata key which contains the version.
+	MetadataVersion = "app-version"
+	// MetadataRevision is the metadata key which contains the revision.
+	MetadataRevision = "app-revision"
+)
+
+// Metadata returns the version and revision of BuildInfo bld as
+// metadata.MD. The revision is omitted when bld does not contain a revision.
+func Metadata(bld *buildinfo.BuildInfo) metadata.MD {
+	return metadata.Pairs(pairs(bld)...)
+}
+
+func pairs(bld *buildinfo.BuildInfo) []string {
+	kv := []string{MetadataVersion, bld.Version()}
+	if rev := bld.Revision(); rev != "" {
+		kv = append(kv, MetadataRevision, rev)
+	}
+	return kv
+}
+
+// UnaryServerInterceptor returns a grpc.UnaryServerInterceptor which attaches
+// the version and revision of BuildInfo bld to the header metadata of each
+// response.
+func UnaryServerInterceptor(bld *buildinfo.BuildInfo) grpc.UnaryServerInterceptor {
+	md := Metadata(bld)
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// headers are not yet sent, so this never returns an error
+		_ = grpc.SetHeader(ctx, md)
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a grpc.StreamServerInterceptor which
+// attaches the version and revision of BuildInfo bld to the header metadata
+// of each stream.
+func StreamServerInterceptor(bld *buildinfo.BuildInfo) grpc.StreamServerInterceptor {
+	md := Metadata(bld)
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// headers are not yet sent, so this never returns an error
+		_ = ss.SetHeader(md)
+		return handler(srv, ss)
+	}
+}
+
+// UnaryClientInterceptor returns a grpc.UnaryClientInterceptor which adds the
+// version and revision of BuildInfo bld to the outgoing metadata of each
+// request. Use CallerFromContext to extract this information server-side.
+func UnaryClientInterceptor(bld *buildinfo.BuildInfo) grpc.UnaryClientInterceptor {
+	kv := pairs(bld)
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, kv...), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor returns a grpc.StreamClientInterceptor which adds
+// the version and revision of BuildInfo bld to the outgoing metadata of each
+// stream. Use CallerFromContext to extract this information server-side.
+func StreamClientInterceptor(bld *buildinfo.BuildInfo) grpc.StreamClientInterceptor {
+	kv := pairs(bld)
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(metadata.AppendToOutgoingContext(ctx, kv...), desc, cc, method, opts...)
+	}
+}
+
+// Caller contains the build information of the client which made a request,
+// as added by UnaryClientInterceptor or StreamClientInterceptor.
+type Caller struct {
+	Version  string
+	Revision string
+}
+
+// CallerFromContext extracts the Caller's build information from the
+// incoming metadata of ctx. It returns false when ctx does not contain any
+// version metadata.
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Caller{}, false
+	}
+	return CallerFromMetadata(md)
+}
+
+// CallerFromMetadata extracts the Caller's build information from
+// metadata.MD md. It returns false when md does not contain any version
+// metadata.
+func CallerFromMetadata(md metadata.MD) (Caller, bool) {
+	ver := md.Get(MetadataVersion)
+	if len(ver) == 0 {
+		return Caller{}, false
+	}
+
+	c := Caller{Version: ver[0]}
+	if rev := md.Get(MetadataRevision); len(rev) != 0 {
+		c.Revision = rev[0]
+	}
+	return c, true
+}
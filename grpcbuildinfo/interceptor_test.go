@@ -0,0 +1,157 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package grpcbuildinfo
+
+import (
+	"context"
+	"runtime/debug"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/go-pogo/buildinfo/grpcbuildinfo/buildinfov1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestMetadata(t *testing.T) {
+	t.Run("version only", func(t *testing.T) {
+		assert.Exactly(t,
+			metadata.Pairs(MetadataVersion, "v1.2.3"),
+			Metadata(&buildinfo.BuildInfo{AltVersion: "v1.2.3"}),
+		)
+	})
+	t.Run("with revision", func(t *testing.T) {
+		bld, err := buildinfo.New("v1.2.3")
+		assert.NoError(t, err)
+
+		bld.Internal().Settings = append(bld.Internal().Settings,
+			debug.BuildSetting{Key: "vcs.revision", Value: "abcdef"},
+		)
+		assert.Exactly(t,
+			metadata.Pairs(MetadataVersion, "v1.2.3", MetadataRevision, "abcdef"),
+			Metadata(bld),
+		)
+	})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+	srv := grpc.NewServer(grpc.UnaryInterceptor(UnaryServerInterceptor(&bld)))
+	Register(srv, &bld)
+
+	var header metadata.MD
+	_, err := buildinfov1.NewBuildInfoServiceClient(serve(t, srv)).GetBuildInfo(
+		context.Background(),
+		&buildinfov1.GetBuildInfoRequest{},
+		grpc.Header(&header),
+	)
+	assert.NoError(t, err)
+	assert.Exactly(t, []string{"v1.2.3"}, header.Get(MetadataVersion))
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+	srv := grpc.NewServer(grpc.StreamInterceptor(StreamServerInterceptor(&bld)))
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := grpc_health_v1.NewHealthClient(serve(t, srv)).
+		Watch(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+
+	header, err := stream.Header()
+	assert.NoError(t, err)
+	assert.Exactly(t, []string{"v1.2.3"}, header.Get(MetadataVersion))
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	var have Caller
+	var ok bool
+
+	srv := grpc.NewServer(grpc.UnaryInterceptor(
+		func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			have, ok = CallerFromContext(ctx)
+			return handler(ctx, req)
+		},
+	))
+	Register(srv, &buildinfo.BuildInfo{AltVersion: "v2.0.0"})
+
+	conn := serve(t, srv, grpc.WithUnaryInterceptor(
+		UnaryClientInterceptor(&buildinfo.BuildInfo{AltVersion: "v1.2.3"}),
+	))
+
+	_, err := buildinfov1.NewBuildInfoServiceClient(conn).
+		GetBuildInfo(context.Background(), &buildinfov1.GetBuildInfoRequest{})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Exactly(t, Caller{Version: "v1.2.3"}, have)
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	have := make(chan Caller, 1)
+
+	srv := grpc.NewServer(grpc.StreamInterceptor(
+		func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			c, _ := CallerFromContext(ss.Context())
+			have <- c
+			return handler(srv, ss)
+		},
+	))
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+
+	conn := serve(t, srv, grpc.WithStreamInterceptor(
+		StreamClientInterceptor(&buildinfo.BuildInfo{AltVersion: "v1.2.3"}),
+	))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := grpc_health_v1.NewHealthClient(conn).
+		Watch(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Exactly(t, Caller{Version: "v1.2.3"}, <-have)
+}
+
+func TestCallerFromMetadata(t *testing.T) {
+	tests := map[string]struct {
+		md     metadata.MD
+		want   Caller
+		wantOk bool
+	}{
+		"empty": {},
+		"revision only": {
+			md: metadata.Pairs(MetadataRevision, "abcdef"),
+		},
+		"version": {
+			md:     metadata.Pairs(MetadataVersion, "v1.2.3"),
+			want:   Caller{Version: "v1.2.3"},
+			wantOk: true,
+		},
+		"version and revision": {
+			md:     metadata.Pairs(MetadataVersion, "v1.2.3", MetadataRevision, "abcdef"),
+			want:   Caller{Version: "v1.2.3", Revision: "abcdef"},
+			wantOk: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			have, ok := CallerFromMetadata(tc.md)
+			assert.Exactly(t, tc.want, have)
+			assert.Exactly(t, tc.wantOk, ok)
+		})
+	}
+
+	t.Run("no metadata in context", func(t *testing.T) {
+		_, ok := CallerFromContext(context.Background())
+		assert.False(t, ok)
+	})
+}
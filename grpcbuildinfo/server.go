@@ -0,0 +1,117 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package grpcbuildinfo provides a gRPC BuildInfoService which exposes the
+// build information of the running service, as well as helpers to register it
+// to a grpc.Server.
+package grpcbuildinfo
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative buildinfov1/buildinfo.proto
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/go-pogo/buildinfo/grpcbuildinfo/buildinfov1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Option configures the Server that is created with NewServer.
+type Option func(s *Server)
+
+// IncludeDeps includes the module dependencies of the build in the response.
+func IncludeDeps() Option {
+	return func(s *Server) { s.deps = true }
+}
+
+// IncludeSettings includes the build settings, such as the used compiler
+// flags and vcs information, in the response.
+func IncludeSettings() Option {
+	return func(s *Server) { s.settings = true }
+}
+
+var _ buildinfov1.BuildInfoServiceServer = (*Server)(nil)
+
+// Server implements buildinfov1.BuildInfoServiceServer and responds with the
+// build information of the BuildInfo it is created with.
+type Server struct {
+	buildinfov1.UnimplementedBuildInfoServiceServer
+
+	deps     bool
+	settings bool
+	info     *buildinfov1.BuildInfo
+}
+
+// NewServer creates a new Server for BuildInfo bld. The response is prepared
+// once, any changes to bld afterwards are not reflected in the response.
+func NewServer(bld *buildinfo.BuildInfo, opts ...Option) *Server {
+	var s Server
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	s.info = Proto(bld)
+	if (s.deps || s.settings) && bld.Internal() != nil {
+		info := bld.Internal()
+		if s.settings {
+			s.info.Settings = make(map[string]string, len(info.Settings))
+			for _, set := range info.Settings {
+				s.info.Settings[set.Key] = set.Value
+			}
+		}
+		if s.deps {
+			s.info.Deps = make([]*buildinfov1.Module, 0, len(info.Deps))
+			for _, dep := range info.Deps {
+				s.info.Deps = append(s.info.Deps, protoModule(dep))
+			}
+		}
+	}
+	return &s
+}
+
+// Register creates a new Server with NewServer and registers it to
+// grpc.ServiceRegistrar reg.
+func Register(reg grpc.ServiceRegistrar, bld *buildinfo.BuildInfo, opts ...Option) *Server {
+	s := NewServer(bld, opts...)
+	buildinfov1.RegisterBuildInfoServiceServer(reg, s)
+	return s
+}
+
+// GetBuildInfo returns the build information of the running service.
+func (s *Server) GetBuildInfo(context.Context, *buildinfov1.GetBuildInfoRequest) (*buildinfov1.GetBuildInfoResponse, error) {
+	return &buildinfov1.GetBuildInfoResponse{
+		BuildInfo: proto.Clone(s.info).(*buildinfov1.BuildInfo),
+	}, nil
+}
+
+// Proto converts BuildInfo bld to a buildinfov1.BuildInfo message. Build
+// settings and module dependencies are not included.
+func Proto(bld *buildinfo.BuildInfo) *buildinfov1.BuildInfo {
+	res := buildinfov1.BuildInfo{
+		Version:     bld.Version(),
+		Revision:    bld.Revision(),
+		GoVersion:   bld.GoVersion(),
+		BuildNumber: bld.BuildNumber,
+		RepoUrl:     bld.RepoURL,
+	}
+	if t := bld.Time(); !t.IsZero() {
+		res.Time = timestamppb.New(t)
+	}
+	return &res
+}
+
+func protoModule(mod *debug.Module) *buildinfov1.Module {
+	if mod == nil {
+		return nil
+	}
+	return &buildinfov1.Module{
+		Path:    mod.Path,
+		Version: mod.Version,
+		Sum:     mod.Sum,
+		Replace: protoModule(mod.Replace),
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package grpcbuildinfo
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/go-pogo/buildinfo/grpcbuildinfo/buildinfov1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProto(t *testing.T) {
+	bld := buildinfo.BuildInfo{
+		AltVersion:  "v1.2.3",
+		BuildNumber: "42",
+		RepoURL:     "https://github.com/go-pogo/buildinfo",
+	}
+	have := Proto(&bld)
+	assert.Exactly(t, "v1.2.3", have.GetVersion())
+	assert.Exactly(t, bld.GoVersion(), have.GetGoVersion())
+	assert.Exactly(t, bld.Revision(), have.GetRevision())
+	assert.Exactly(t, "42", have.GetBuildNumber())
+	assert.Exactly(t, "https://github.com/go-pogo/buildinfo", have.GetRepoUrl())
+}
+
+func TestNewServer(t *testing.T) {
+	bld, err := buildinfo.New("v1.2.3")
+	assert.NoError(t, err)
+
+	t.Run("default", func(t *testing.T) {
+		have, err := NewServer(bld).GetBuildInfo(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(Proto(bld), have.GetBuildInfo()))
+		assert.Nil(t, have.GetBuildInfo().GetSettings())
+		assert.Nil(t, have.GetBuildInfo().GetDeps())
+	})
+	t.Run("settings", func(t *testing.T) {
+		have, err := NewServer(bld, IncludeSettings()).GetBuildInfo(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Len(t, have.GetBuildInfo().GetSettings(), len(bld.Internal().Settings))
+	})
+	t.Run("deps", func(t *testing.T) {
+		have, err := NewServer(bld, IncludeDeps()).GetBuildInfo(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Len(t, have.GetBuildInfo().GetDeps(), len(bld.Internal().Deps))
+	})
+}
+
+func TestRegister(t *testing.T) {
+	srv := grpc.NewServer()
+	Register(srv, &buildinfo.BuildInfo{AltVersion: "v1.2.3"})
+
+	have, err := buildinfov1.NewBuildInfoServiceClient(serve(t, srv)).
+		GetBuildInfo(context.Background(), &buildinfov1.GetBuildInfoRequest{})
+	assert.NoError(t, err)
+	assert.Exactly(t, "v1.2.3", have.GetBuildInfo().GetVersion())
+}
+
+// serve starts grpc.Server srv on an in-memory listener and returns a client
+// connection to it. Both are stopped when the test finishes.
+func serve(t *testing.T, srv *grpc.Server, opts ...grpc.DialOption) *grpc.ClientConn {
+	lis := bufconn.Listen(1024 * 1024)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	opts = append(opts,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
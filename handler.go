@@ -5,20 +5,337 @@
 package buildinfo
 
 import (
+	"bytes"
+	"encoding/json"
+	"hash/fnv"
 	"net/http"
-
-	"github.com/go-pogo/writing"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 // HTTPHandler is the http.Handler that writes BuildInfo bld as a JSON response
-// to the http response.
-func HTTPHandler(bld *BuildInfo) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-		h := w.Header()
-		h.Set("Content-Type", "application/json")
-		if t := bld.Time(); !t.IsZero() {
-			h.Set("Last-Modified", t.Format(http.TimeFormat))
+// to the http response. It is identical to calling NewHandler without any
+// HandlerOption(s).
+func HTTPHandler(bld *BuildInfo) http.Handler { return NewHandler(bld) }
+
+// HandlerOption configures the http.Handler that is created with NewHandler.
+type HandlerOption func(h *handler)
+
+// IncludeDeps includes the module dependencies of the build in the JSON
+// response.
+func IncludeDeps() HandlerOption {
+	return func(h *handler) { h.deps = true }
+}
+
+// IncludeSettings includes the build settings, such as the used compiler
+// flags and vcs information, in the JSON response.
+func IncludeSettings() HandlerOption {
+	return func(h *handler) { h.settings = true }
+}
+
+// Pretty indents the JSON response by default. Regardless of this option, the
+// output can always be toggled per request with the "pretty" query parameter,
+// e.g. /version?pretty=1.
+func Pretty() HandlerOption {
+	return func(h *handler) { h.pretty = true }
+}
+
+// AllowOrigin enables CORS support by setting the Access-Control-Allow-Origin
+// header for requests from any of the provided origins. Use "*" to allow
+// requests from any origin. Preflight OPTIONS requests are answered with a
+// 204 No Content response.
+func AllowOrigin(origins ...string) HandlerOption {
+	return func(h *handler) { h.origins = append(h.origins, origins...) }
+}
+
+// NewHandler creates a new http.Handler which writes BuildInfo bld as a JSON
+// response to the http response. It sets an ETag header derived from the JSON
+// response, and a Last-Modified header with the build time when available. A
+// 304 Not Modified response is returned when the request's If-None-Match
+// header matches the ETag, or when its If-Modified-Since header is at or after
+// the build time. HEAD requests are answered without a body.
+// The response is prepared once when the handler is created, any changes to
+// bld afterwards are not reflected in the response.
+func NewHandler(bld *BuildInfo, opts ...HandlerOption) http.Handler {
+	return newHandler(bld, opts...)
+}
+
+func newHandler(bld *BuildInfo, opts ...HandlerOption) *handler {
+	h := handler{bld: bld}
+	for _, opt := range opts {
+		opt(&h)
+	}
+
+	// BuildInfo does not change after the handler is created, which means all
+	// response data can be prepared once instead of with each request
+	h.json = h.marshalJson()
+	h.etag = etag(h.json)
+	if t := bld.Time(); !t.IsZero() {
+		h.modTime = t
+		h.lastModified = t.Format(http.TimeFormat)
+	}
+	return &h
+}
+
+// Register registers the http.Handler created with NewHandler and
+// HandlerOption(s) opts to mux at PathPattern. The registered handler only
+// serves GET and HEAD requests, and OPTIONS requests when CORS is enabled
+// with AllowOrigin. Other methods result in a 405 Method Not Allowed
+// response. The returned string is the pattern that is used to register the
+// handler.
+func Register(mux *http.ServeMux, bld *BuildInfo, opts ...HandlerOption) string {
+	h := newHandler(bld, opts...)
+	methods := []string{http.MethodGet, http.MethodHead}
+	if len(h.origins) != 0 {
+		methods = append(methods, http.MethodOptions)
+	}
+
+	mux.Handle(PathPattern, allowMethods(h, methods...))
+	return PathPattern
+}
+
+func allowMethods(next http.Handler, methods ...string) http.Handler {
+	allow := strings.Join(methods, ", ")
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		for _, m := range methods {
+			if req.Method == m {
+				next.ServeHTTP(w, req)
+				return
+			}
 		}
-		bld.writeJson(writing.ToStringWriter(w))
+
+		w.Header().Set("Allow", allow)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	})
+}
+
+type handler struct {
+	bld      *BuildInfo
+	deps     bool
+	settings bool
+	pretty   bool
+	origins  []string
+
+	etag         string
+	modTime      time.Time
+	lastModified string
+	json         []byte
+
+	prettyOnce sync.Once
+	prettyJson []byte
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if len(h.origins) != 0 && h.cors(w, req) {
+		return
+	}
+
+	hdr := w.Header()
+	hdr.Set("ETag", h.etag)
+	if h.lastModified != "" {
+		hdr.Set("Last-Modified", h.lastModified)
+	}
+	if notModified(req, h.etag, h.modTime) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	hdr.Set("Content-Type", "application/json")
+	if req.Method == http.MethodHead {
+		return
+	}
+
+	pretty := h.pretty
+	if v, ok := queryBool(req, "pretty"); ok {
+		pretty = v
+	}
+	if !pretty {
+		_, _ = w.Write(h.json)
+		return
+	}
+
+	h.prettyOnce.Do(func() {
+		var buf bytes.Buffer
+		// h.json always contains valid JSON
+		_ = json.Indent(&buf, h.json, "", "  ")
+		h.prettyJson = buf.Bytes()
 	})
+	_, _ = w.Write(h.prettyJson)
+}
+
+// cors sets the CORS headers when the origin of req is allowed. It returns
+// true when req is a preflight request, which is then completely handled.
+func (h *handler) cors(w http.ResponseWriter, req *http.Request) bool {
+	hdr := w.Header()
+	hdr.Add("Vary", "Origin")
+
+	var allowed bool
+	if origin := req.Header.Get("Origin"); origin != "" {
+		if allow := h.allowOrigin(origin); allow != "" {
+			allowed = true
+			hdr.Set("Access-Control-Allow-Origin", allow)
+			hdr.Set("Access-Control-Expose-Headers", "ETag")
+		}
+	}
+	if req.Method != http.MethodOptions {
+		return false
+	}
+
+	if allowed {
+		hdr.Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+		hdr.Set("Access-Control-Allow-Headers", "If-None-Match, If-Modified-Since")
+	}
+	hdr.Set("Allow", "GET, HEAD, OPTIONS")
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
+
+func (h *handler) allowOrigin(origin string) string {
+	for _, o := range h.origins {
+		if o == "*" {
+			return o
+		}
+		if strings.EqualFold(o, origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+func (h *handler) marshalJson() []byte {
+	var buf bytes.Buffer
+	h.bld.writeJson(&buf)
+
+	if (h.deps || h.settings) && h.bld.init() {
+		// remove closing brace so additional fields can be appended
+		buf.Truncate(buf.Len() - 1)
+
+		// marshaling maps and structs with only string fields never returns
+		// an error
+		if h.settings {
+			settings := make(map[string]string, len(h.bld.info.Settings))
+			for _, set := range h.bld.info.Settings {
+				settings[set.Key] = set.Value
+			}
+
+			b, _ := json.Marshal(settings)
+			_, _ = buf.WriteString(`,"settings":`)
+			_, _ = buf.Write(b)
+		}
+		if h.deps {
+			deps := make([]*jsonModule, 0, len(h.bld.info.Deps))
+			for _, dep := range h.bld.info.Deps {
+				deps = append(deps, newJsonModule(dep))
+			}
+
+			b, _ := json.Marshal(deps)
+			_, _ = buf.WriteString(`,"deps":`)
+			_, _ = buf.Write(b)
+		}
+		_ = buf.WriteByte('}')
+	}
+	return buf.Bytes()
+}
+
+type jsonModule struct {
+	Path    string      `json:"path"`
+	Version string      `json:"version,omitempty"`
+	Sum     string      `json:"sum,omitempty"`
+	Replace *jsonModule `json:"replace,omitempty"`
+}
+
+func newJsonModule(mod *debug.Module) *jsonModule {
+	if mod == nil {
+		return nil
+	}
+	return &jsonModule{
+		Path:    mod.Path,
+		Version: mod.Version,
+		Sum:     mod.Sum,
+		Replace: newJsonModule(mod.Replace),
+	}
+}
+
+// queryBool returns the boolean value of query parameter key and whether it
+// is present in the url of req. A present key without value is considered
+// true, e.g. /version?pretty.
+func queryBool(req *http.Request, key string) (val, ok bool) {
+	q := req.URL.Query()
+	if _, ok = q[key]; !ok {
+		return false, false
+	}
+
+	v := q.Get(key)
+	if v == "" {
+		return true, true
+	}
+
+	val, err := strconv.ParseBool(v)
+	return val, err == nil
+}
+
+// notModified reports whether the conditional headers of req indicate the
+// client already has the current representation. Just like
+// http.ServeContent, If-Modified-Since is ignored when the request contains
+// an If-None-Match header.
+func notModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+	if inm := req.Header.Values("If-None-Match"); len(inm) != 0 {
+		return etagMatch(inm, etag)
+	}
+	if lastModified.IsZero() {
+		return false
+	}
+
+	ims, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	// the Last-Modified header has a resolution of one second
+	return !lastModified.Truncate(time.Second).After(ims)
+}
+
+// etag returns a strong entity tag which is stable for as long as the
+// response body does not change.
+func etag(body []byte) string {
+	h := fnv.New64a()
+	_, _ = h.Write(body)
+	return `"` + strconv.FormatUint(h.Sum64(), 16) + `"`
+}
+
+// etagMatch reports whether etag matches any of the entity tags within the
+// If-None-Match header values, using the weak comparison function as
+// described in RFC 9110 section 8.8.3.2.
+func etagMatch(values []string, etag string) bool {
+	for _, val := range values {
+		for _, tag := range strings.Split(val, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Middleware returns a middleware which sets the HeaderVersion and
+// HeaderRevision headers on every response. The revision header is omitted
+// when BuildInfo bld does not contain a revision.
+func Middleware(bld *BuildInfo) func(next http.Handler) http.Handler {
+	ver, rev := bld.Version(), bld.Revision()
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			h := w.Header()
+			h.Set(HeaderVersion, ver)
+			if rev != "" {
+				h.Set(HeaderRevision, rev)
+			}
+			next.ServeHTTP(w, req)
+		})
+	}
 }
@@ -5,8 +5,12 @@
 package buildinfo
 
 import (
+	"encoding/json"
+	"net/http"
 	"net/http/httptest"
+	"runtime/debug"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -15,8 +19,391 @@ func TestHttpHandler(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			rec := httptest.NewRecorder()
-			HTTPHandler(&tc.wantStruct).ServeHTTP(rec, nil)
+			req := httptest.NewRequest(http.MethodGet, PathPattern, nil)
+			HTTPHandler(&tc.wantStruct).ServeHTTP(rec, req)
 			assert.Exactly(t, []byte(tc.wantJson), rec.Body.Bytes())
+			assert.Exactly(t, etag([]byte(tc.wantJson)), rec.Header().Get("ETag"))
+		})
+	}
+}
+
+func TestHttpHandler_Cache(t *testing.T) {
+	bld := BuildInfo{AltVersion: "v1.2.3"}
+	handler := HTTPHandler(&bld)
+
+	bld.AltVersion = "v2.0.0"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, PathPattern, nil))
+
+	assert.Exactly(t, etag(rec.Body.Bytes()), rec.Header().Get("ETag"))
+	assert.Contains(t, rec.Body.String(), `"version":"v1.2.3"`)
+}
+
+func BenchmarkHttpHandler(b *testing.B) {
+	handler := HTTPHandler(&BuildInfo{AltVersion: "v1.2.3"})
+	req := httptest.NewRequest(http.MethodGet, PathPattern, nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+}
+
+func TestHttpHandler_ETag(t *testing.T) {
+	bld := BuildInfo{AltVersion: "v1.2.3"}
+	tag := newHandler(&bld).etag
+
+	tests := map[string]struct {
+		ifNoneMatch string
+		wantStatus  int
+	}{
+		"no header":    {wantStatus: http.StatusOK},
+		"match":        {ifNoneMatch: tag, wantStatus: http.StatusNotModified},
+		"weak match":   {ifNoneMatch: "W/" + tag, wantStatus: http.StatusNotModified},
+		"list match":   {ifNoneMatch: `"foo", ` + tag, wantStatus: http.StatusNotModified},
+		"wildcard":     {ifNoneMatch: "*", wantStatus: http.StatusNotModified},
+		"no match":     {ifNoneMatch: `"foo"`, wantStatus: http.StatusOK},
+		"partial list": {ifNoneMatch: `"foo", "bar"`, wantStatus: http.StatusOK},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, PathPattern, nil)
+			if tc.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tc.ifNoneMatch)
+			}
+
+			HTTPHandler(&bld).ServeHTTP(rec, req)
+			assert.Exactly(t, tc.wantStatus, rec.Code)
+			assert.Exactly(t, tag, rec.Header().Get("ETag"))
+			if tc.wantStatus == http.StatusNotModified {
+				assert.Empty(t, rec.Body.Bytes())
+			}
+		})
+	}
+
+	t.Run("stable", func(t *testing.T) {
+		assert.Exactly(t, tag, newHandler(&BuildInfo{AltVersion: "v1.2.3"}).etag)
+		assert.NotEqual(t, tag, newHandler(&BuildInfo{AltVersion: "v1.2.4"}).etag)
+	})
+	t.Run("build number", func(t *testing.T) {
+		assert.NotEqual(t, tag, newHandler(&BuildInfo{
+			AltVersion:  "v1.2.3",
+			BuildNumber: "42",
+		}).etag)
+	})
+	t.Run("go version", func(t *testing.T) {
+		assert.NotEqual(t, tag, newHandler(&BuildInfo{
+			info:       &debug.BuildInfo{GoVersion: "go1.22.0"},
+			AltVersion: "v1.2.3",
+		}).etag)
+	})
+}
+
+func TestHttpHandler_LastModified(t *testing.T) {
+	buildTime := time.Date(2020, 6, 16, 19, 53, 0, 0, time.UTC)
+	bld := BuildInfo{
+		info: &debug.BuildInfo{
+			Settings: []debug.BuildSetting{
+				{Key: keyTime, Value: buildTime.Format(time.RFC3339)},
+			},
+		},
+		AltVersion: "v1.2.3",
+	}
+
+	tests := map[string]struct {
+		method     string
+		header     http.Header
+		wantStatus int
+	}{
+		"no header": {
+			wantStatus: http.StatusOK,
+		},
+		"before build time": {
+			header:     http.Header{"If-Modified-Since": {buildTime.Add(-time.Hour).Format(http.TimeFormat)}},
+			wantStatus: http.StatusOK,
+		},
+		"at build time": {
+			header:     http.Header{"If-Modified-Since": {buildTime.Format(http.TimeFormat)}},
+			wantStatus: http.StatusNotModified,
+		},
+		"after build time": {
+			header:     http.Header{"If-Modified-Since": {buildTime.Add(time.Hour).Format(http.TimeFormat)}},
+			wantStatus: http.StatusNotModified,
+		},
+		"invalid": {
+			header:     http.Header{"If-Modified-Since": {"yesterday"}},
+			wantStatus: http.StatusOK,
+		},
+		"head": {
+			method:     http.MethodHead,
+			header:     http.Header{"If-Modified-Since": {buildTime.Format(http.TimeFormat)}},
+			wantStatus: http.StatusNotModified,
+		},
+		"if-none-match takes precedence": {
+			header: http.Header{
+				"If-None-Match":     {`"foo"`},
+				"If-Modified-Since": {buildTime.Format(http.TimeFormat)},
+			},
+			wantStatus: http.StatusOK,
+		},
+		"post": {
+			method:     http.MethodPost,
+			header:     http.Header{"If-Modified-Since": {buildTime.Format(http.TimeFormat)}},
+			wantStatus: http.StatusOK,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.method == "" {
+				tc.method = http.MethodGet
+			}
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.method, PathPattern, nil)
+			for k, v := range tc.header {
+				req.Header[k] = v
+			}
+
+			HTTPHandler(&bld).ServeHTTP(rec, req)
+			assert.Exactly(t, tc.wantStatus, rec.Code)
+			assert.Exactly(t, buildTime.Format(http.TimeFormat), rec.Header().Get("Last-Modified"))
+		})
+	}
+}
+
+func TestHttpHandler_Head(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodHead, PathPattern, nil)
+	HTTPHandler(&BuildInfo{AltVersion: "v1.2.3"}).ServeHTTP(rec, req)
+
+	assert.Exactly(t, http.StatusOK, rec.Code)
+	assert.Exactly(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.NotEmpty(t, rec.Header().Get("ETag"))
+	assert.Empty(t, rec.Body.Bytes())
+}
+
+func TestNewHandler(t *testing.T) {
+	bld := BuildInfo{
+		info: &debug.BuildInfo{
+			GoVersion: "go1.20",
+			Deps: []*debug.Module{
+				{Path: "github.com/go-pogo/errors", Version: "v0.11.2", Sum: "h1:abc="},
+				{
+					Path:    "github.com/go-pogo/writing",
+					Version: "v0.2.1",
+					Replace: &debug.Module{Path: "../writing"},
+				},
+			},
+			Settings: []debug.BuildSetting{
+				{Key: "-compiler", Value: "gc"},
+				{Key: keyRevision, Value: "abcdefghi"},
+			},
+		},
+		AltVersion: "v0.66",
+	}
+
+	const (
+		pretty   = "{\n  \"version\": \"v0.66\",\n  \"revision\": \"abcdefghi\",\n  \"goversion\": \"go1.20\"\n}"
+		base     = `{"version":"v0.66","revision":"abcdefghi","goversion":"go1.20"`
+		settings = `"settings":{"-compiler":"gc","vcs.revision":"abcdefghi"}`
+		deps     = `"deps":[{"path":"github.com/go-pogo/errors","version":"v0.11.2","sum":"h1:abc="},{"path":"github.com/go-pogo/writing","version":"v0.2.1","replace":{"path":"../writing"}}]`
+	)
+
+	tests := map[string]struct {
+		opts   []HandlerOption
+		target string
+		want   string
+	}{
+		"default": {
+			want: base + `}`,
+		},
+		"settings": {
+			opts: []HandlerOption{IncludeSettings()},
+			want: base + `,` + settings + `}`,
+		},
+		"deps": {
+			opts: []HandlerOption{IncludeDeps()},
+			want: base + `,` + deps + `}`,
+		},
+		"settings and deps": {
+			opts: []HandlerOption{IncludeSettings(), IncludeDeps()},
+			want: base + `,` + settings + `,` + deps + `}`,
+		},
+		"pretty": {
+			opts: []HandlerOption{Pretty()},
+			want: pretty,
+		},
+		"pretty query": {
+			target: PathPattern + "?pretty=1",
+			want:   pretty,
+		},
+		"pretty query without value": {
+			target: PathPattern + "?pretty",
+			want:   pretty,
+		},
+		"pretty disabled by query": {
+			opts:   []HandlerOption{Pretty()},
+			target: PathPattern + "?pretty=false",
+			want:   base + `}`,
+		},
+		"invalid pretty query": {
+			target: PathPattern + "?pretty=nope",
+			want:   base + `}`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.target == "" {
+				tc.target = PathPattern
+			}
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			NewHandler(&bld, tc.opts...).ServeHTTP(rec, req)
+
+			assert.Exactly(t, http.StatusOK, rec.Code)
+			assert.Exactly(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.Exactly(t, tc.want, rec.Body.String())
+			if len(tc.opts) > 0 {
+				assert.True(t, json.Valid(rec.Body.Bytes()))
+			}
+		})
+	}
+}
+
+func TestAllowOrigin(t *testing.T) {
+	bld := BuildInfo{AltVersion: "v1.2.3"}
+
+	tests := map[string]struct {
+		origins    []string
+		method     string
+		origin     string
+		wantStatus int
+		wantOrigin string
+	}{
+		"any origin": {
+			origins:    []string{"*"},
+			method:     http.MethodGet,
+			origin:     "https://dashboard.example.com",
+			wantStatus: http.StatusOK,
+			wantOrigin: "*",
+		},
+		"allowed origin": {
+			origins:    []string{"https://foo.example.com", "https://dashboard.example.com"},
+			method:     http.MethodGet,
+			origin:     "https://dashboard.example.com",
+			wantStatus: http.StatusOK,
+			wantOrigin: "https://dashboard.example.com",
+		},
+		"disallowed origin": {
+			origins:    []string{"https://foo.example.com"},
+			method:     http.MethodGet,
+			origin:     "https://dashboard.example.com",
+			wantStatus: http.StatusOK,
+		},
+		"no origin": {
+			origins:    []string{"*"},
+			method:     http.MethodGet,
+			wantStatus: http.StatusOK,
+		},
+		"preflight": {
+			origins:    []string{"https://dashboard.example.com"},
+			method:     http.MethodOptions,
+			origin:     "https://dashboard.example.com",
+			wantStatus: http.StatusNoContent,
+			wantOrigin: "https://dashboard.example.com",
+		},
+		"disallowed preflight": {
+			origins:    []string{"https://foo.example.com"},
+			method:     http.MethodOptions,
+			origin:     "https://dashboard.example.com",
+			wantStatus: http.StatusNoContent,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			Register(mux, &bld, AllowOrigin(tc.origins...))
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.method, PathPattern, nil)
+			if tc.origin != "" {
+				req.Header.Set("Origin", tc.origin)
+			}
+			mux.ServeHTTP(rec, req)
+
+			assert.Exactly(t, tc.wantStatus, rec.Code)
+			assert.Exactly(t, tc.wantOrigin, rec.Header().Get("Access-Control-Allow-Origin"))
+			if tc.method == http.MethodOptions {
+				assert.Empty(t, rec.Body.Bytes())
+				if tc.wantOrigin != "" {
+					assert.NotEmpty(t, rec.Header().Get("Access-Control-Allow-Methods"))
+				}
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, PathPattern, nil)
+		req.Header.Set("Origin", "https://dashboard.example.com")
+		NewHandler(&bld).ServeHTTP(rec, req)
+
+		assert.NotContains(t, rec.Header(), "Access-Control-Allow-Origin")
+		assert.NotContains(t, rec.Header(), "Vary")
+	})
+}
+
+func TestRegister(t *testing.T) {
+	bld := BuildInfo{AltVersion: "v1.2.3"}
+	mux := http.NewServeMux()
+	assert.Exactly(t, PathPattern, Register(mux, &bld))
+
+	tests := map[string]struct {
+		method     string
+		wantStatus int
+	}{
+		http.MethodGet:     {method: http.MethodGet, wantStatus: http.StatusOK},
+		http.MethodHead:    {method: http.MethodHead, wantStatus: http.StatusOK},
+		http.MethodPost:    {method: http.MethodPost, wantStatus: http.StatusMethodNotAllowed},
+		http.MethodOptions: {method: http.MethodOptions, wantStatus: http.StatusMethodNotAllowed},
+		http.MethodDelete:  {method: http.MethodDelete, wantStatus: http.StatusMethodNotAllowed},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(tc.method, PathPattern, nil))
+
+			assert.Exactly(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus == http.StatusMethodNotAllowed {
+				assert.Exactly(t, "GET, HEAD", rec.Header().Get("Allow"))
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var called bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusTeapot)
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			Middleware(&tc.wantStruct)(next).ServeHTTP(rec, req)
+
+			assert.True(t, called)
+			assert.Exactly(t, http.StatusTeapot, rec.Code)
+			assert.Exactly(t, tc.wantMap[keyVersion], rec.Header().Get(HeaderVersion))
+			assert.Exactly(t, tc.wantMap[keyRevision], rec.Header().Get(HeaderRevision))
+			if _, ok := tc.wantMap[keyRevision]; !ok {
+				assert.NotContains(t, rec.Header(), HeaderRevision)
+			}
 		})
 	}
 }
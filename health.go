@@ -0,0 +1,90 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// HealthStatusOK is the status in the HealthHandler response when the
+	// service is ready.
+	HealthStatusOK = "ok"
+	// HealthStatusUnavailable is the status in the HealthHandler response
+	// when the service is not ready.
+	HealthStatusUnavailable = "unavailable"
+)
+
+// ReadinessFunc reports whether the service is ready to serve requests by
+// returning a nil error.
+type ReadinessFunc func(ctx context.Context) error
+
+// HealthOption configures the http.Handler that is created with HealthHandler.
+type HealthOption func(h *healthHandler)
+
+// IncludeError adds the message of the error returned by the ReadinessFunc
+// to the HealthHandler response. It is omitted by default, as the message may
+// contain internal details which should not be exposed to every caller.
+func IncludeError() HealthOption {
+	return func(h *healthHandler) { h.includeErr = true }
+}
+
+type healthHandler struct {
+	includeErr bool
+}
+
+// HealthHandler is the http.Handler that writes the health status of the
+// service, together with BuildInfo bld, as a JSON response, e.g.
+// {"status":"ok","build":{"version":"v1.2.3",...}}. When ReadinessFunc ready
+// returns an error, the status is "unavailable" and the status code is 503
+// Service Unavailable. Use IncludeError to also add the error message to the
+// response. A nil ready func always reports the service as ready, which makes
+// the handler usable as a liveness probe.
+func HealthHandler(bld *BuildInfo, ready ReadinessFunc, opts ...HealthOption) http.Handler {
+	var h healthHandler
+	for _, opt := range opts {
+		opt(&h)
+	}
+
+	var buf bytes.Buffer
+	bld.writeJson(&buf)
+	build := buf.Bytes()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		code, status := http.StatusOK, HealthStatusOK
+		var err error
+		if ready != nil {
+			if err = ready(req.Context()); err != nil {
+				code, status = http.StatusServiceUnavailable, HealthStatusUnavailable
+			}
+		}
+
+		hdr := w.Header()
+		hdr.Set("Content-Type", "application/json")
+		hdr.Set("Cache-Control", "no-store")
+		w.WriteHeader(code)
+		if req.Method == http.MethodHead {
+			return
+		}
+
+		var res bytes.Buffer
+		_, _ = res.WriteString(`{"status":"`)
+		_, _ = res.WriteString(status)
+		_ = res.WriteByte('"')
+		if err != nil && h.includeErr {
+			// marshaling a string never returns an error
+			msg, _ := json.Marshal(err.Error())
+			_, _ = res.WriteString(`,"error":`)
+			_, _ = res.Write(msg)
+		}
+		_, _ = res.WriteString(`,"build":`)
+		_, _ = res.Write(build)
+		_ = res.WriteByte('}')
+		_, _ = w.Write(res.Bytes())
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthHandler(t *testing.T) {
+	bld := BuildInfo{AltVersion: "v1.2.3"}
+	build := `{"version":"v1.2.3","goversion":"` + runtime.Version() + `"}`
+
+	tests := map[string]struct {
+		ready    ReadinessFunc
+		opts     []HealthOption
+		wantCode int
+		wantBody string
+	}{
+		"nil": {
+			wantCode: http.StatusOK,
+			wantBody: `{"status":"ok","build":` + build + `}`,
+		},
+		"ready": {
+			ready:    func(context.Context) error { return nil },
+			wantCode: http.StatusOK,
+			wantBody: `{"status":"ok","build":` + build + `}`,
+		},
+		"not ready": {
+			ready:    func(context.Context) error { return errors.New(`db "main" down`) },
+			wantCode: http.StatusServiceUnavailable,
+			wantBody: `{"status":"unavailable","build":` + build + `}`,
+		},
+		"not ready with error": {
+			ready:    func(context.Context) error { return errors.New(`db "main" down`) },
+			opts:     []HealthOption{IncludeError()},
+			wantCode: http.StatusServiceUnavailable,
+			wantBody: `{"status":"unavailable","error":"db \"main\" down","build":` + build + `}`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/health", nil)
+			HealthHandler(&bld, tc.ready, tc.opts...).ServeHTTP(rec, req)
+
+			assert.Exactly(t, tc.wantCode, rec.Code)
+			assert.Exactly(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.Exactly(t, "no-store", rec.Header().Get("Cache-Control"))
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+		})
+	}
+
+	t.Run("head", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodHead, "/health", nil)
+		HealthHandler(&bld, nil).ServeHTTP(rec, req)
+
+		assert.Exactly(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.Bytes())
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package httpmw provides standard func(http.Handler) http.Handler middlewares
+// which work with plain net/http, as well as routers like chi and
+// gorilla/mux.
+//
+// Note that gorilla/mux only runs middlewares added with Router.Use when a
+// route matches. To serve the build information with Route regardless of the
+// registered routes, wrap the router itself instead:
+//
+//	http.ListenAndServe(addr, httpmw.Route(bld)(router))
+package httpmw
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-pogo/buildinfo"
+)
+
+// Middleware is a standard http middleware.
+type Middleware = func(next http.Handler) http.Handler
+
+// Headers returns a Middleware which sets the buildinfo.HeaderVersion and
+// buildinfo.HeaderRevision headers on every response. See
+// buildinfo.Middleware for details.
+func Headers(bld *buildinfo.BuildInfo) Middleware {
+	return buildinfo.Middleware(bld)
+}
+
+// Route returns a Middleware which responds to GET and HEAD requests for
+// buildinfo.PathPattern with the http.Handler created with
+// buildinfo.NewHandler, without calling the next http.Handler. All other
+// requests are passed to the next http.Handler.
+func Route(bld *buildinfo.BuildInfo, opts ...buildinfo.HandlerOption) Middleware {
+	return RouteAt(buildinfo.PathPattern, bld, opts...)
+}
+
+// RouteAt is identical to Route, except it responds to requests for path
+// instead of buildinfo.PathPattern. Paths are matched case-insensitive.
+func RouteAt(path string, bld *buildinfo.BuildInfo, opts ...buildinfo.HandlerOption) Middleware {
+	handler := buildinfo.NewHandler(bld, opts...)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if (req.Method == http.MethodGet || req.Method == http.MethodHead) &&
+				strings.EqualFold(req.URL.Path, path) {
+				handler.ServeHTTP(w, req)
+				return
+			}
+			next.ServeHTTP(w, req)
+		})
+	}
+}
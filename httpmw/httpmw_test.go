@@ -0,0 +1,150 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httpmw
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-pogo/buildinfo"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+var bld = buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+
+func hello(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte("hello"))
+}
+
+func serve(h http.Handler, method, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	return rec
+}
+
+func assertVersion(t *testing.T, rec *httptest.ResponseRecorder) {
+	want, _ := bld.MarshalJSON()
+	assert.Exactly(t, http.StatusOK, rec.Code)
+	assert.Exactly(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Exactly(t, string(want), rec.Body.String())
+}
+
+func TestRouteAt(t *testing.T) {
+	handler := RouteAt("/build", &bld)(http.HandlerFunc(hello))
+
+	assertVersion(t, serve(handler, http.MethodGet, "/build"))
+	assertVersion(t, serve(handler, http.MethodGet, "/BUILD"))
+	assert.Exactly(t, "hello", serve(handler, http.MethodGet, buildinfo.PathPattern).Body.String())
+	assert.Exactly(t, "hello", serve(handler, http.MethodPost, "/build").Body.String())
+}
+
+func TestStd(t *testing.T) {
+	m := http.NewServeMux()
+	m.HandleFunc("/hello", hello)
+	handler := Headers(&bld)(Route(&bld)(m))
+
+	t.Run("route", func(t *testing.T) {
+		rec := serve(handler, http.MethodGet, buildinfo.PathPattern)
+		assertVersion(t, rec)
+		assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+	})
+	t.Run("head", func(t *testing.T) {
+		rec := serve(handler, http.MethodHead, buildinfo.PathPattern)
+		assert.Exactly(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.Bytes())
+	})
+	t.Run("post", func(t *testing.T) {
+		assert.Exactly(t, http.StatusNotFound, serve(handler, http.MethodPost, buildinfo.PathPattern).Code)
+	})
+	t.Run("other route", func(t *testing.T) {
+		rec := serve(handler, http.MethodGet, "/hello")
+		assert.Exactly(t, "hello", rec.Body.String())
+		assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+	})
+	t.Run("not found", func(t *testing.T) {
+		rec := serve(handler, http.MethodGet, "/foo")
+		assert.Exactly(t, http.StatusNotFound, rec.Code)
+		assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+	})
+}
+
+func TestChi(t *testing.T) {
+	// chi runs middlewares of the router regardless of whether a route matches
+	r := chi.NewRouter()
+	r.Use(Headers(&bld), Route(&bld))
+	r.Get("/hello", hello)
+
+	t.Run("route", func(t *testing.T) {
+		rec := serve(r, http.MethodGet, buildinfo.PathPattern)
+		assertVersion(t, rec)
+		assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+	})
+	t.Run("post", func(t *testing.T) {
+		assert.Exactly(t, http.StatusNotFound, serve(r, http.MethodPost, buildinfo.PathPattern).Code)
+	})
+	t.Run("other route", func(t *testing.T) {
+		rec := serve(r, http.MethodGet, "/hello")
+		assert.Exactly(t, "hello", rec.Body.String())
+		assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+	})
+	t.Run("not found", func(t *testing.T) {
+		rec := serve(r, http.MethodGet, "/foo")
+		assert.Exactly(t, http.StatusNotFound, rec.Code)
+		assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+	})
+	t.Run("sub router", func(t *testing.T) {
+		r := chi.NewRouter()
+		r.Route("/api", func(r chi.Router) {
+			r.Use(RouteAt("/api"+buildinfo.PathPattern, &bld))
+			r.Get("/hello", hello)
+		})
+
+		assertVersion(t, serve(r, http.MethodGet, "/api"+buildinfo.PathPattern))
+		assert.Exactly(t, http.StatusNotFound, serve(r, http.MethodGet, buildinfo.PathPattern).Code)
+	})
+}
+
+func TestGorillaMux(t *testing.T) {
+	t.Run("use", func(t *testing.T) {
+		// gorilla/mux only runs middlewares when a route matches
+		r := mux.NewRouter()
+		r.Use(Headers(&bld), Route(&bld))
+		r.HandleFunc("/hello", hello)
+
+		rec := serve(r, http.MethodGet, buildinfo.PathPattern)
+		assert.Exactly(t, http.StatusNotFound, rec.Code)
+		assert.Empty(t, rec.Header().Get(buildinfo.HeaderVersion))
+
+		rec = serve(r, http.MethodGet, "/hello")
+		assert.Exactly(t, "hello", rec.Body.String())
+		assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+	})
+	t.Run("wrap", func(t *testing.T) {
+		r := mux.NewRouter()
+		r.HandleFunc("/hello", hello)
+		handler := Headers(&bld)(Route(&bld)(r))
+
+		rec := serve(handler, http.MethodGet, buildinfo.PathPattern)
+		assertVersion(t, rec)
+		assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+
+		rec = serve(handler, http.MethodGet, "/foo")
+		assert.Exactly(t, http.StatusNotFound, rec.Code)
+		assert.Exactly(t, "v1.2.3", rec.Header().Get(buildinfo.HeaderVersion))
+	})
+	t.Run("use with placeholder route", func(t *testing.T) {
+		// a placeholder route makes sure the middleware is run
+		r := mux.NewRouter()
+		r.Use(Route(&bld))
+		r.Handle(buildinfo.PathPattern, http.NotFoundHandler()).
+			Methods(http.MethodGet, http.MethodHead)
+
+		assertVersion(t, serve(r, http.MethodGet, buildinfo.PathPattern))
+		assert.Exactly(t, http.StatusMethodNotAllowed, serve(r, http.MethodPost, buildinfo.PathPattern).Code)
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import "strings"
+
+const (
+	// KubernetesLabelName is the recommended Kubernetes label for the name
+	// of the application.
+	KubernetesLabelName = "app.kubernetes.io/name"
+	// KubernetesLabelVersion is the recommended Kubernetes label for the
+	// version of the application.
+	KubernetesLabelVersion = "app.kubernetes.io/version"
+)
+
+// KubernetesLabels returns the recommended Kubernetes labels
+// KubernetesLabelName and KubernetesLabelVersion. When appName is empty, the
+// name is BuildInfo.Name.
+// Values are sanitized to be valid label values, e.g. v1.2.3+meta becomes
+// v1.2.3_meta. Labels with an empty value are omitted.
+func (bld *BuildInfo) KubernetesLabels(appName string) map[string]string {
+	if appName == "" {
+		appName = bld.Name()
+	}
+
+	labels := make(map[string]string, 2)
+	if v := kubernetesLabelValue(appName); v != "" {
+		labels[KubernetesLabelName] = v
+	}
+	if v := kubernetesLabelValue(bld.Version()); v != "" {
+		labels[KubernetesLabelVersion] = v
+	}
+	return labels
+}
+
+// kubernetesLabelValue replaces invalid characters with an underscore,
+// truncates s to 63 characters and trims non-alphanumeric characters from both
+// ends.
+func kubernetesLabelValue(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if isAlphaNum(r) || r == '-' || r == '_' || r == '.' {
+			return r
+		}
+		return '_'
+	}, s)
+	if len(s) > 63 {
+		s = s[:63]
+	}
+	return strings.TrimFunc(s, func(r rune) bool { return !isAlphaNum(r) })
+}
+
+func isAlphaNum(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
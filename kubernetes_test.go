@@ -0,0 +1,70 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildInfo_KubernetesLabels(t *testing.T) {
+	tests := map[string]struct {
+		input   BuildInfo
+		appName string
+		want    map[string]string
+	}{
+		"app name": {
+			input:   BuildInfo{AltVersion: "v1.2.3"},
+			appName: "myapp",
+			want: map[string]string{
+				KubernetesLabelName:    "myapp",
+				KubernetesLabelVersion: "v1.2.3",
+			},
+		},
+		"module path": {
+			input: BuildInfo{
+				info:       &debug.BuildInfo{Main: debug.Module{Path: "github.com/go-pogo/myapp"}},
+				AltVersion: "v1.2.3+meta",
+			},
+			want: map[string]string{
+				KubernetesLabelName:    "myapp",
+				KubernetesLabelVersion: "v1.2.3_meta",
+			},
+		},
+		"no name": {
+			input: BuildInfo{
+				info:       &debug.BuildInfo{},
+				AltVersion: "v1.2.3",
+			},
+			want: map[string]string{
+				KubernetesLabelVersion: "v1.2.3",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Exactly(t, tc.want, tc.input.KubernetesLabels(tc.appName))
+		})
+	}
+}
+
+func TestKubernetesLabelValue(t *testing.T) {
+	tests := map[string]string{
+		"v1.2.3":                       "v1.2.3",
+		"v1.2.3+meta":                  "v1.2.3_meta",
+		"-v1.2.3-":                     "v1.2.3",
+		"(devel)":                      "devel",
+		strings.Repeat("a", 70):        strings.Repeat("a", 63),
+		strings.Repeat("a", 62) + "-b": strings.Repeat("a", 62),
+	}
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			assert.Exactly(t, want, kubernetesLabelValue(input))
+		})
+	}
+}
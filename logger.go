@@ -0,0 +1,37 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import "time"
+
+// StartupMessage is the message which is logged by LogStartup.
+var StartupMessage = "starting"
+
+// Logger is a minimal structured logger which logs a message at info level
+// with alternating keys and values. A *slog.Logger satisfies this interface.
+// The zapbuildinfo module provides an adapter for zap.
+type Logger interface {
+	Info(msg string, keysAndValues ...any)
+}
+
+// LogStartup logs StartupMessage together with the app name, version,
+// revision, build time and go version of BuildInfo bld, using Logger l. Use it
+// once at startup, so all services log their build in the same shape. Empty
+// fields are omitted.
+func LogStartup(l Logger, bld *BuildInfo) {
+	kv := make([]any, 0, 10)
+	if name := bld.Name(); name != "" {
+		kv = append(kv, "app", name)
+	}
+	kv = append(kv, "version", bld.Version())
+	if rev := bld.Revision(); rev != "" {
+		kv = append(kv, "revision", rev)
+	}
+	if t := bld.Time(); !t.IsZero() {
+		kv = append(kv, "time", t.Format(time.RFC3339))
+	}
+	kv = append(kv, "goversion", bld.GoVersion())
+	l.Info(StartupMessage, kv...)
+}
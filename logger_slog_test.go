@@ -0,0 +1,23 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.21
+
+package buildinfo
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Logger = (*slog.Logger)(nil)
+
+func TestLogStartup_slog(t *testing.T) {
+	var buf bytes.Buffer
+	LogStartup(slog.New(slog.NewTextHandler(&buf, nil)), &BuildInfo{AltName: "myapp", AltVersion: "v1.2.3"})
+	assert.Contains(t, buf.String(), "msg="+StartupMessage+" app=myapp version=v1.2.3 ")
+}
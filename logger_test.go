@@ -0,0 +1,65 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testLogger struct {
+	msg string
+	kv  []any
+}
+
+func (l *testLogger) Info(msg string, keysAndValues ...any) {
+	l.msg, l.kv = msg, keysAndValues
+}
+
+func TestLogStartup(t *testing.T) {
+	tests := map[string]struct {
+		input BuildInfo
+		want  []any
+	}{
+		"version only": {
+			input: BuildInfo{
+				info:       &debug.BuildInfo{GoVersion: "go1.22.0"},
+				AltVersion: "v1.2.3",
+			},
+			want: []any{"version", "v1.2.3", "goversion", "go1.22.0"},
+		},
+		"all": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					GoVersion: "go1.22.0",
+					Settings: []debug.BuildSetting{
+						{Key: keyRevision, Value: "fedcba"},
+						{Key: keyTime, Value: time.Date(2020, 6, 16, 19, 53, 0, 0, time.UTC).Format(time.RFC3339)},
+					},
+				},
+				AltName:    "myapp",
+				AltVersion: "v1.2.3",
+			},
+			want: []any{
+				"app", "myapp",
+				"version", "v1.2.3",
+				"revision", "fedcba",
+				"time", "2020-06-16T19:53:00Z",
+				"goversion", "go1.22.0",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var l testLogger
+			LogStartup(&l, &tc.input)
+			assert.Exactly(t, StartupMessage, l.msg)
+			assert.Exactly(t, tc.want, l.kv)
+		})
+	}
+}
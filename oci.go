@@ -0,0 +1,60 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"time"
+
+	"github.com/go-pogo/errors"
+)
+
+const (
+	// OCILabelVersion is the OCI image annotation for the version.
+	OCILabelVersion = "org.opencontainers.image.version"
+	// OCILabelRevision is the OCI image annotation for the revision.
+	OCILabelRevision = "org.opencontainers.image.revision"
+	// OCILabelCreated is the OCI image annotation for the creation time.
+	OCILabelCreated = "org.opencontainers.image.created"
+)
+
+// OCILabels returns the build information as OCI image annotations, e.g.
+// org.opencontainers.image.version. The build time is used as creation time.
+// Labels with an empty value are omitted. This keeps image metadata in sync
+// with the metadata of the binary.
+func (bld *BuildInfo) OCILabels() map[string]string {
+	labels := map[string]string{OCILabelVersion: bld.Version()}
+	if rev := bld.Revision(); rev != "" {
+		labels[OCILabelRevision] = rev
+	}
+	if t := bld.Time(); !t.IsZero() {
+		labels[OCILabelCreated] = t.Format(time.RFC3339)
+	}
+	return labels
+}
+
+const ErrLabelMismatch = "label does not match build information"
+
+// VerifyOCILabels verifies that the OCILabelVersion and OCILabelRevision
+// labels match the build information of BuildInfo bld. Missing labels are not
+// verified, neither is OCILabelCreated as the image may be created at a
+// different time than the binary. Use it to check the labels of
+// a built image against the binary it contains.
+func (bld *BuildInfo) VerifyOCILabels(labels map[string]string) error {
+	want := bld.OCILabels()
+
+	var err error
+	for _, key := range []string{OCILabelVersion, OCILabelRevision} {
+		have, ok := labels[key]
+		if !ok {
+			continue
+		}
+		if have != want[key] {
+			errors.AppendInto(&err, errors.Wrapf(errors.New(ErrLabelMismatch),
+				"%s: %q, want %q", key, have, want[key],
+			))
+		}
+	}
+	return err
+}
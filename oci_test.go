@@ -0,0 +1,80 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildInfo_OCILabels(t *testing.T) {
+	tests := map[string]struct {
+		input BuildInfo
+		want  map[string]string
+	}{
+		"version only": {
+			input: BuildInfo{
+				info:       &debug.BuildInfo{},
+				AltVersion: "v1.2.3",
+			},
+			want: map[string]string{OCILabelVersion: "v1.2.3"},
+		},
+		"all": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					Settings: []debug.BuildSetting{
+						{Key: keyRevision, Value: "fedcba"},
+						{Key: keyTime, Value: time.Date(2020, 6, 16, 19, 53, 0, 0, time.UTC).Format(time.RFC3339)},
+					},
+				},
+				AltVersion: "v1.2.3",
+			},
+			want: map[string]string{
+				OCILabelVersion:  "v1.2.3",
+				OCILabelRevision: "fedcba",
+				OCILabelCreated:  "2020-06-16T19:53:00Z",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Exactly(t, tc.want, tc.input.OCILabels())
+		})
+	}
+}
+
+func TestBuildInfo_VerifyOCILabels(t *testing.T) {
+	bld := BuildInfo{
+		info: &debug.BuildInfo{
+			Settings: []debug.BuildSetting{
+				{Key: keyRevision, Value: "fedcba"},
+			},
+		},
+		AltVersion: "v1.2.3",
+	}
+
+	t.Run("match", func(t *testing.T) {
+		assert.NoError(t, bld.VerifyOCILabels(bld.OCILabels()))
+		assert.NoError(t, bld.VerifyOCILabels(map[string]string{
+			OCILabelVersion: "v1.2.3",
+			"other":         "value",
+		}))
+	})
+	t.Run("mismatch", func(t *testing.T) {
+		err := bld.VerifyOCILabels(map[string]string{
+			OCILabelVersion:  "v1.2.4",
+			OCILabelRevision: "abcdef",
+			OCILabelCreated:  "2020-06-16T19:53:00Z",
+		})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), OCILabelVersion+`: "v1.2.4", want "v1.2.3"`)
+			assert.Contains(t, err.Error(), OCILabelRevision+`: "abcdef", want "fedcba"`)
+			assert.NotContains(t, err.Error(), OCILabelCreated)
+		}
+	})
+}
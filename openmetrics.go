@@ -0,0 +1,124 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-pogo/errors"
+)
+
+// OpenMetricsContentType is the content type of the exposition written by
+// BuildInfo.WriteOpenMetrics.
+const OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// OpenMetricsOption configures the output of BuildInfo.WriteOpenMetrics.
+type OpenMetricsOption func(om *openMetrics)
+
+// IncludeTimestamp additionally writes a <namespace>_build_timestamp_seconds
+// gauge with the build time as Unix timestamp value, which makes it possible
+// to alert on the age of the running build. The metric is omitted when the
+// build time is not available.
+func IncludeTimestamp() OpenMetricsOption {
+	return func(om *openMetrics) { om.timestamp = true }
+}
+
+type openMetrics struct {
+	timestamp bool
+}
+
+// WriteOpenMetrics writes the MetricName gauge, prefixed with namespace and
+// with the build information as labels, in the OpenMetrics text exposition
+// format to w. The output is also valid for the Prometheus text format, which
+// makes it possible to expose the metric without depending on a Prometheus
+// client library:
+//
+//	http.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+//	    w.Header().Set("Content-Type", buildinfo.OpenMetricsContentType)
+//	    _ = bld.WriteOpenMetrics(w, "myapp")
+//	})
+func (bld *BuildInfo) WriteOpenMetrics(w io.Writer, namespace string, opts ...OpenMetricsOption) error {
+	var om openMetrics
+	for _, opt := range opts {
+		opt(&om)
+	}
+
+	name := metricName(namespace, MetricName)
+
+	m := bld.Map()
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	writeMetricFamily(&sb, name, MetricHelp)
+	sb.WriteString(name)
+	sb.WriteByte('{')
+	for i, k := range keys {
+		if i != 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(metricLabelName(k))
+		sb.WriteString(`="`)
+		sb.WriteString(escapeMetricText(m[k], true))
+		sb.WriteByte('"')
+	}
+	sb.WriteString("} 1\n")
+
+	if t := bld.Time(); om.timestamp && !t.IsZero() {
+		name = metricName(namespace, TimestampMetricName)
+		writeMetricFamily(&sb, name, TimestampMetricHelp)
+		sb.WriteString(name)
+		sb.WriteByte(' ')
+		sb.WriteString(strconv.FormatInt(t.Unix(), 10))
+		sb.WriteByte('\n')
+	}
+	sb.WriteString("# EOF\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return errors.WithStack(err)
+}
+
+func metricName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "_" + name
+}
+
+// writeMetricFamily writes the TYPE and HELP metadata of gauge name to sb.
+func writeMetricFamily(sb *strings.Builder, name, help string) {
+	sb.WriteString("# TYPE ")
+	sb.WriteString(name)
+	sb.WriteString(" gauge\n# HELP ")
+	sb.WriteString(name)
+	sb.WriteByte(' ')
+	sb.WriteString(escapeMetricText(help, false))
+	sb.WriteByte('\n')
+}
+
+// metricLabelName replaces all characters which are not allowed in a label
+// name with an underscore, e.g. vcs.revision becomes vcs_revision.
+func metricLabelName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+func escapeMetricText(s string, quotes bool) string {
+	r := strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	if quotes {
+		r = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+	}
+	return r.Replace(s)
+}
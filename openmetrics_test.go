@@ -0,0 +1,84 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildInfo_WriteOpenMetrics(t *testing.T) {
+	help := "# HELP %s " + MetricHelp + "\n"
+	tests := map[string]struct {
+		input     BuildInfo
+		namespace string
+		opts      []OpenMetricsOption
+		want      string
+	}{
+		"version only": {
+			input: BuildInfo{AltVersion: "v1.2.3"},
+			want: "# TYPE buildinfo gauge\n" +
+				strings.Replace(help, "%s", "buildinfo", 1) +
+				`buildinfo{goversion="` + runtime.Version() + `",version="v1.2.3"} 1` + "\n" +
+				"# EOF\n",
+		},
+		"namespace and revision": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					GoVersion: "go1.22.0",
+					Settings: []debug.BuildSetting{
+						{Key: keyRevision, Value: "fedcba"},
+					},
+				},
+				AltVersion: `v1"2`,
+			},
+			namespace: "myapp",
+			want: "# TYPE myapp_buildinfo gauge\n" +
+				strings.Replace(help, "%s", "myapp_buildinfo", 1) +
+				`myapp_buildinfo{goversion="go1.22.0",vcs_revision="fedcba",version="v1\"2"} 1` + "\n" +
+				"# EOF\n",
+		},
+		"timestamp": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					GoVersion: "go1.22.0",
+					Settings: []debug.BuildSetting{
+						{Key: keyTime, Value: "2020-06-16T19:53:00Z"},
+					},
+				},
+				AltVersion: "v1.2.3",
+			},
+			namespace: "myapp",
+			opts:      []OpenMetricsOption{IncludeTimestamp()},
+			want: "# TYPE myapp_buildinfo gauge\n" +
+				strings.Replace(help, "%s", "myapp_buildinfo", 1) +
+				`myapp_buildinfo{goversion="go1.22.0",vcs_time="2020-06-16T19:53:00Z",version="v1.2.3"} 1` + "\n" +
+				"# TYPE myapp_build_timestamp_seconds gauge\n" +
+				"# HELP myapp_build_timestamp_seconds " + TimestampMetricHelp + "\n" +
+				"myapp_build_timestamp_seconds 1592337180\n" +
+				"# EOF\n",
+		},
+		"timestamp without time": {
+			input:     BuildInfo{AltVersion: "v1.2.3"},
+			namespace: "myapp",
+			opts:      []OpenMetricsOption{IncludeTimestamp()},
+			want: "# TYPE myapp_buildinfo gauge\n" +
+				strings.Replace(help, "%s", "myapp_buildinfo", 1) +
+				`myapp_buildinfo{goversion="` + runtime.Version() + `",version="v1.2.3"} 1` + "\n" +
+				"# EOF\n",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var sb strings.Builder
+			assert.NoError(t, tc.input.WriteOpenMetrics(&sb, tc.namespace, tc.opts...))
+			assert.Exactly(t, tc.want, sb.String())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package otelbuildinfo
+
+import (
+	"context"
+
+	"github.com/go-pogo/buildinfo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Gauge registers an observable gauge named <namespace>_buildinfo on
+// metric.Meter meter. The gauge has a constant value of 1 and the Attributes
+// of BuildInfo bld. Its name is just buildinfo.MetricName when namespace is
+// empty. This mirrors the collector of the prombuildinfo module.
+func Gauge(meter metric.Meter, namespace string, bld *buildinfo.BuildInfo) (metric.Int64ObservableGauge, error) {
+	set := attribute.NewSet(Attributes(bld)...)
+	return meter.Int64ObservableGauge(metricName(namespace, buildinfo.MetricName),
+		metric.WithDescription(buildinfo.MetricHelp),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(1, metric.WithAttributeSet(set))
+			return nil
+		}),
+	)
+}
+
+// TimestampGauge registers an observable gauge named
+// <namespace>_build_timestamp_seconds on metric.Meter meter, with the build
+// time of BuildInfo bld as Unix timestamp value. No value is observed when the
+// build time is unknown.
+func TimestampGauge(meter metric.Meter, namespace string, bld *buildinfo.BuildInfo) (metric.Int64ObservableGauge, error) {
+	t := bld.Time()
+	return meter.Int64ObservableGauge(metricName(namespace, buildinfo.TimestampMetricName),
+		metric.WithDescription(buildinfo.TimestampMetricHelp),
+		metric.WithUnit("s"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			if !t.IsZero() {
+				o.Observe(t.Unix())
+			}
+			return nil
+		}),
+	)
+}
+
+func metricName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "_" + name
+}
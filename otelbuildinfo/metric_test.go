@@ -0,0 +1,85 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package otelbuildinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/stretchr/testify/assert"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+func TestGauge(t *testing.T) {
+	tests := map[string]struct {
+		namespace string
+		wantName  string
+	}{
+		"without namespace": {
+			wantName: buildinfo.MetricName,
+		},
+		"with namespace": {
+			namespace: "myapp",
+			wantName:  "myapp_" + buildinfo.MetricName,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			reader := sdkmetric.NewManualReader()
+			meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+
+			bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+			_, err := Gauge(meter, tc.namespace, &bld)
+			assert.NoError(t, err)
+
+			var rm metricdata.ResourceMetrics
+			assert.NoError(t, reader.Collect(context.Background(), &rm))
+			if !assert.Len(t, rm.ScopeMetrics, 1) || !assert.Len(t, rm.ScopeMetrics[0].Metrics, 1) {
+				return
+			}
+
+			m := rm.ScopeMetrics[0].Metrics[0]
+			assert.Exactly(t, tc.wantName, m.Name)
+			assert.Exactly(t, buildinfo.MetricHelp, m.Description)
+
+			gauge, ok := m.Data.(metricdata.Gauge[int64])
+			if !assert.True(t, ok) || !assert.Len(t, gauge.DataPoints, 1) {
+				return
+			}
+			assert.Exactly(t, int64(1), gauge.DataPoints[0].Value)
+
+			ver, ok := gauge.DataPoints[0].Attributes.Value(semconv.ServiceVersionKey)
+			assert.True(t, ok)
+			assert.Exactly(t, "v1.2.3", ver.AsString())
+		})
+	}
+}
+
+func TestTimestampGauge(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+
+	_, err := TimestampGauge(meter, "myapp", &buildinfo.BuildInfo{AltVersion: "v1.2.3"})
+	assert.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+	// the build time of the test binary is unknown, so nothing is observed
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			gauge, ok := m.Data.(metricdata.Gauge[int64])
+			assert.True(t, ok)
+			assert.Empty(t, gauge.DataPoints)
+		}
+	}
+}
+
+func TestMetricName(t *testing.T) {
+	assert.Exactly(t, "buildinfo", metricName("", "buildinfo"))
+	assert.Exactly(t, "myapp_buildinfo", metricName("myapp", "buildinfo"))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package otelbuildinfo provides OpenTelemetry integrations for BuildInfo.
+//
+//	res, err := resource.New(ctx,
+//	    resource.WithDetectors(otelbuildinfo.Detector(bld)),
+//	)
+//
+// Gauge registers the build information as an observable gauge on a
+// metric.Meter:
+//
+//	_, err := otelbuildinfo.Gauge(meter, "myapp", bld)
+package otelbuildinfo
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pogo/buildinfo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+// KeyVCSTime is the attribute key of the build time, formatted as RFC 3339.
+// There is no semantic convention for this attribute.
+const KeyVCSTime = attribute.Key("vcs.time")
+
+// KeyBuildNumber is the attribute key of the number of the CI build which
+// produced the release. There is no semantic convention for this attribute.
+const KeyBuildNumber = attribute.Key("buildnumber")
+
+var _ resource.Detector = (*detector)(nil)
+
+// Detector returns a resource.Detector which detects the Attributes of
+// BuildInfo bld.
+func Detector(bld *buildinfo.BuildInfo) resource.Detector {
+	return &detector{bld: bld}
+}
+
+type detector struct {
+	bld *buildinfo.BuildInfo
+}
+
+func (d *detector) Detect(context.Context) (*resource.Resource, error) {
+	// a schemaless resource can always be merged with resources of other
+	// detectors, regardless of the semconv version they use
+	return resource.NewSchemaless(Attributes(d.bld)...), nil
+}
+
+// Attributes returns the build information of BuildInfo bld as semantic
+// convention attributes. These are service.version, process.runtime.version
+// and, when available, service.name, vcs.ref.head.revision, KeyVCSTime,
+// KeyBuildNumber and vcs.repository.url.full. Only BuildInfo.AltName is used
+// as service.name.
+func Attributes(bld *buildinfo.BuildInfo) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, 7)
+	if bld.AltName != "" {
+		attrs = append(attrs, semconv.ServiceName(bld.AltName))
+	}
+
+	attrs = append(attrs,
+		semconv.ServiceVersion(bld.Version()),
+		semconv.ProcessRuntimeVersion(bld.GoVersion()),
+	)
+	if rev := bld.Revision(); rev != "" {
+		attrs = append(attrs, semconv.VCSRefHeadRevision(rev))
+	}
+	if t := bld.Time(); !t.IsZero() {
+		attrs = append(attrs, KeyVCSTime.String(t.Format(time.RFC3339)))
+	}
+	if bld.BuildNumber != "" {
+		attrs = append(attrs, KeyBuildNumber.String(bld.BuildNumber))
+	}
+	if bld.RepoURL != "" {
+		attrs = append(attrs, semconv.VCSRepositoryURLFull(bld.RepoURL))
+	}
+	return attrs
+}
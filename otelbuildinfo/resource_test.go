@@ -0,0 +1,90 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package otelbuildinfo
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+func TestAttributes(t *testing.T) {
+	tests := map[string]struct {
+		input buildinfo.BuildInfo
+		want  []attribute.KeyValue
+	}{
+		"version": {
+			input: buildinfo.BuildInfo{AltVersion: "v1.2.3"},
+			want: []attribute.KeyValue{
+				semconv.ServiceVersion("v1.2.3"),
+				semconv.ProcessRuntimeVersion(runtime.Version()),
+			},
+		},
+		"name": {
+			input: buildinfo.BuildInfo{AltName: "myapp", AltVersion: "v1.2.3"},
+			want: []attribute.KeyValue{
+				semconv.ServiceName("myapp"),
+				semconv.ServiceVersion("v1.2.3"),
+				semconv.ProcessRuntimeVersion(runtime.Version()),
+			},
+		},
+		"build number and repo url": {
+			input: buildinfo.BuildInfo{
+				AltVersion:  "v1.2.3",
+				BuildNumber: "42",
+				RepoURL:     "https://github.com/go-pogo/buildinfo",
+			},
+			want: []attribute.KeyValue{
+				semconv.ServiceVersion("v1.2.3"),
+				semconv.ProcessRuntimeVersion(runtime.Version()),
+				KeyBuildNumber.String("42"),
+				semconv.VCSRepositoryURLFull("https://github.com/go-pogo/buildinfo"),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Exactly(t, tc.want, Attributes(&tc.input))
+		})
+	}
+}
+
+func TestDetector(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltName: "myapp", AltVersion: "v1.2.3"}
+	res, err := resource.New(context.Background(), resource.WithDetectors(Detector(&bld)))
+	assert.NoError(t, err)
+
+	val, ok := res.Set().Value(semconv.ServiceVersionKey)
+	assert.True(t, ok)
+	assert.Exactly(t, "v1.2.3", val.AsString())
+
+	val, ok = res.Set().Value(semconv.ServiceNameKey)
+	assert.True(t, ok)
+	assert.Exactly(t, "myapp", val.AsString())
+}
+
+func TestDetector_Merge(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+	res, err := resource.New(context.Background(),
+		resource.WithDetectors(Detector(&bld)),
+		resource.WithTelemetrySDK(),
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	res, err = resource.Merge(resource.Default(), res)
+	assert.NoError(t, err)
+
+	val, ok := res.Set().Value(semconv.ServiceVersionKey)
+	assert.True(t, ok)
+	assert.Exactly(t, "v1.2.3", val.AsString())
+}
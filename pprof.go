@@ -0,0 +1,26 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"context"
+	"runtime/pprof"
+)
+
+// PprofLabels returns a copy of ctx with the version and revision of BuildInfo
+// bld added as pprof labels. This allows grouping profiles by build within
+// continuous profiling backends. The labels are applied to the current
+// goroutine, and goroutines it creates, with pprof.SetGoroutineLabels:
+//
+//	pprof.SetGoroutineLabels(buildinfo.PprofLabels(ctx, bld))
+//
+// The revision label is omitted when bld does not contain a revision.
+func PprofLabels(ctx context.Context, bld *BuildInfo) context.Context {
+	kv := []string{"version", bld.Version()}
+	if rev := bld.Revision(); rev != "" {
+		kv = append(kv, "revision", rev)
+	}
+	return pprof.WithLabels(ctx, pprof.Labels(kv...))
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"context"
+	"runtime/debug"
+	"runtime/pprof"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPprofLabels(t *testing.T) {
+	tests := map[string]struct {
+		input BuildInfo
+		want  map[string]string
+	}{
+		"version only": {
+			input: BuildInfo{AltVersion: "v1.2.3"},
+			want:  map[string]string{"version": "v1.2.3"},
+		},
+		"version and revision": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					Settings: []debug.BuildSetting{
+						{Key: keyRevision, Value: "fedcba"},
+					},
+				},
+				AltVersion: "v1.2.3",
+			},
+			want: map[string]string{"version": "v1.2.3", "revision": "fedcba"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			have := make(map[string]string)
+			pprof.ForLabels(PprofLabels(context.Background(), &tc.input), func(k, v string) bool {
+				have[k] = v
+				return true
+			})
+			assert.Exactly(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package prombuildinfo provides a prometheus.Collector which exposes the
+// build information as a metric with constant labels and a constant value of
+// 1.
+//
+//	prometheus.MustRegister(prombuildinfo.NewCollector("myapp", bld))
+package prombuildinfo
+
+import (
+	"strings"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// CollectorOption configures the prometheus.Collector that is created with
+// NewCollector.
+type CollectorOption func(c *collector)
+
+// IncludeTimestamp additionally emits a <namespace>_build_timestamp_seconds
+// gauge with the build time as Unix timestamp value. This makes it possible
+// to alert on the age of the running build, e.g.
+// time() - myapp_build_timestamp_seconds > 86400 * 30. The metric is omitted
+// when the build time is unknown.
+func IncludeTimestamp() CollectorOption {
+	return func(c *collector) { c.timestamp = true }
+}
+
+// NewCollector creates a new prometheus.Collector which emits a gauge named
+// <namespace>_buildinfo, with a constant value of 1 and labels derived from
+// BuildInfo.Map. Label names are sanitized to be valid Prometheus label names,
+// e.g. vcs.revision becomes vcs_revision.
+func NewCollector(namespace string, bld *buildinfo.BuildInfo, opts ...CollectorOption) prometheus.Collector {
+	var c collector
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	c.metrics = append(c.metrics, constGauge(MetricOpts(namespace, bld), 1))
+	if t := bld.Time(); c.timestamp && !t.IsZero() {
+		c.metrics = append(c.metrics, constGauge(TimestampOpts(namespace), float64(t.Unix())))
+	}
+	return &c
+}
+
+func constGauge(opts prometheus.GaugeOpts, val float64) prometheus.Metric {
+	return prometheus.MustNewConstMetric(
+		prometheus.NewDesc(
+			prometheus.BuildFQName(opts.Namespace, opts.Subsystem, opts.Name),
+			opts.Help,
+			nil,
+			opts.ConstLabels,
+		),
+		prometheus.GaugeValue,
+		val,
+	)
+}
+
+type collector struct {
+	timestamp bool
+	metrics   []prometheus.Metric
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, m := range c.metrics {
+		ch <- m.Desc()
+	}
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	for _, m := range c.metrics {
+		ch <- m
+	}
+}
+
+// MetricOpts returns prometheus.GaugeOpts with buildinfo.MetricName,
+// buildinfo.MetricHelp and the Labels of BuildInfo bld as constant labels.
+// Use it to create a custom gauge, e.g.
+//
+//	prometheus.NewGaugeFunc(prombuildinfo.MetricOpts("myapp", bld),
+//	    func() float64 { return 1 },
+//	)
+func MetricOpts(namespace string, bld *buildinfo.BuildInfo) prometheus.GaugeOpts {
+	return prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Name:        buildinfo.MetricName,
+		Help:        buildinfo.MetricHelp,
+		ConstLabels: Labels(bld),
+	}
+}
+
+// TimestampOpts returns prometheus.GaugeOpts with
+// buildinfo.TimestampMetricName and buildinfo.TimestampMetricHelp.
+func TimestampOpts(namespace string) prometheus.GaugeOpts {
+	return prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      buildinfo.TimestampMetricName,
+		Help:      buildinfo.TimestampMetricHelp,
+	}
+}
+
+// Labels returns BuildInfo.Map with its keys sanitized to be valid
+// Prometheus label names.
+func Labels(bld *buildinfo.BuildInfo) prometheus.Labels {
+	m := bld.Map()
+	labels := make(prometheus.Labels, len(m))
+	for k, v := range m {
+		labels[labelName(k)] = v
+	}
+	return labels
+}
+
+func labelName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, s)
+}
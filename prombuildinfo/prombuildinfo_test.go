@@ -0,0 +1,87 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prombuildinfo
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCollector(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+
+	reg := prometheus.NewPedanticRegistry()
+	assert.NoError(t, reg.Register(NewCollector("myapp", &bld)))
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP myapp_buildinfo `+buildinfo.MetricHelp+`
+# TYPE myapp_buildinfo gauge
+myapp_buildinfo{goversion="`+runtime.Version()+`",version="v1.2.3"} 1
+`)))
+}
+
+func TestIncludeTimestamp(t *testing.T) {
+	t.Run("unknown time", func(t *testing.T) {
+		bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+		reg := prometheus.NewPedanticRegistry()
+		assert.NoError(t, reg.Register(NewCollector("myapp", &bld, IncludeTimestamp())))
+
+		n, err := testutil.GatherAndCount(reg, "myapp_"+buildinfo.TimestampMetricName)
+		assert.NoError(t, err)
+		assert.Exactly(t, 0, n)
+	})
+	t.Run("gauge", func(t *testing.T) {
+		reg := prometheus.NewPedanticRegistry()
+		assert.NoError(t, reg.Register(&collector{
+			metrics: []prometheus.Metric{constGauge(TimestampOpts("myapp"), 1592337180)},
+		}))
+		assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP myapp_build_timestamp_seconds `+buildinfo.TimestampMetricHelp+`
+# TYPE myapp_build_timestamp_seconds gauge
+myapp_build_timestamp_seconds 1.59233718e+09
+`)))
+	})
+}
+
+func TestMetricOpts(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltVersion: "v1.2.3"}
+	have := MetricOpts("myapp", &bld)
+
+	assert.Exactly(t, "myapp", have.Namespace)
+	assert.Exactly(t, buildinfo.MetricName, have.Name)
+	assert.Exactly(t, buildinfo.MetricHelp, have.Help)
+	assert.Exactly(t, Labels(&bld), have.ConstLabels)
+}
+
+func TestLabels(t *testing.T) {
+	bld, err := buildinfo.New("v1.2.3")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	labels := Labels(bld)
+	assert.Len(t, labels, len(bld.Map()))
+	for name := range labels {
+		assert.NotContains(t, name, ".")
+	}
+}
+
+func TestLabelName(t *testing.T) {
+	tests := map[string]string{
+		"version":      "version",
+		"vcs.revision": "vcs_revision",
+		"a-b c":        "a_b_c",
+	}
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			assert.Exactly(t, want, labelName(input))
+		})
+	}
+}
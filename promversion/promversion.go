@@ -0,0 +1,112 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package promversion is a drop-in replacement for the Info, BuildContext and
+Print functions of github.com/prometheus/common/version, backed by
+buildinfo.BuildInfo. It eases migrating exporters onto buildinfo without
+changing their --version output.
+
+	func main() {
+	    bld, _ := buildinfo.New(version)
+	    promversion.Use(bld)
+
+	    fmt.Println(promversion.Print("my_exporter"))
+	}
+*/
+package promversion
+
+import (
+	"bytes"
+	"runtime"
+	"strings"
+	"text/template"
+
+	"github.com/go-pogo/buildinfo"
+)
+
+// Build information, these are populated by Use. Just like
+// prometheus/common/version, the variables may also be set with ldflags.
+var (
+	Version   string
+	Revision  string
+	Branch    string
+	BuildUser string
+	BuildDate string
+	GoVersion = runtime.Version()
+	GoOS      = runtime.GOOS
+	GoArch    = runtime.GOARCH
+	Tags      = "unknown"
+)
+
+// DateFormat is the layout which is used to format the build time.
+const DateFormat = "20060102-15:04:05"
+
+// Use populates the package variables with the build information of
+// BuildInfo bld. Variables which have no counterpart within bld, like Branch
+// and BuildUser, are left untouched.
+func Use(bld *buildinfo.BuildInfo) {
+	Version = bld.Version()
+	if rev := bld.Revision(); rev != "" {
+		Revision = rev
+	}
+	if t := bld.Time(); !t.IsZero() {
+		BuildDate = t.UTC().Format(DateFormat)
+	}
+	GoVersion = bld.GoVersion()
+	if goos := bld.Setting("GOOS"); goos != "" {
+		GoOS = goos
+	}
+	if goarch := bld.Setting("GOARCH"); goarch != "" {
+		GoArch = goarch
+	}
+	if tags := bld.Setting("-tags"); tags != "" {
+		Tags = tags
+	}
+}
+
+var versionInfoTmpl = template.Must(template.New("version").Parse(
+	`{{.program}}, version {{.version}} (branch: {{.branch}}, revision: {{.revision}})
+  build user:       {{.buildUser}}
+  build date:       {{.buildDate}}
+  go version:       {{.goVersion}}
+  platform:         {{.platform}}
+  tags:             {{.tags}}`,
+))
+
+// Print returns version information in the same format as
+// prometheus/common/version.Print.
+func Print(program string) string {
+	m := map[string]string{
+		"program":   program,
+		"version":   Version,
+		"revision":  Revision,
+		"branch":    Branch,
+		"buildUser": BuildUser,
+		"buildDate": BuildDate,
+		"goVersion": GoVersion,
+		"platform":  GoOS + "/" + GoArch,
+		"tags":      Tags,
+	}
+
+	var buf bytes.Buffer
+	// executing the template with a map of strings never returns an error
+	_ = versionInfoTmpl.Execute(&buf, m)
+	return strings.TrimSpace(buf.String())
+}
+
+// Info returns version, branch and revision information.
+func Info() string {
+	return "(version=" + Version + ", branch=" + Branch + ", revision=" + Revision + ")"
+}
+
+// BuildContext returns go version, platform, build user, date and tags
+// information.
+func BuildContext() string {
+	return "(go=" + GoVersion +
+		", platform=" + GoOS + "/" + GoArch +
+		", user=" + BuildUser +
+		", date=" + BuildDate +
+		", tags=" + Tags + ")"
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package promversion
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUse(t *testing.T) {
+	Use(&buildinfo.BuildInfo{AltVersion: "1.2.3"})
+	Branch, BuildUser, BuildDate = "main", "roel@host", "20200616-19:53:00"
+	Revision = "fedcba"
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+
+	t.Run("Info", func(t *testing.T) {
+		assert.Exactly(t, "(version=1.2.3, branch=main, revision=fedcba)", Info())
+	})
+	t.Run("BuildContext", func(t *testing.T) {
+		assert.Exactly(t,
+			"(go="+runtime.Version()+", platform="+platform+", user=roel@host, date=20200616-19:53:00, tags="+Tags+")",
+			BuildContext(),
+		)
+	})
+	t.Run("Print", func(t *testing.T) {
+		assert.Exactly(t, "my_exporter, version 1.2.3 (branch: main, revision: fedcba)\n"+
+			"  build user:       roel@host\n"+
+			"  build date:       20200616-19:53:00\n"+
+			"  go version:       "+runtime.Version()+"\n"+
+			"  platform:         "+platform+"\n"+
+			"  tags:             "+Tags,
+			Print("my_exporter"),
+		)
+	})
+}
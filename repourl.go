@@ -0,0 +1,47 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"net/url"
+	"strings"
+)
+
+// NormalizeRepoURL normalizes the ssh and https forms of a git remote url to
+// a https url which can be linked to, e.g. git@github.com:go-pogo/buildinfo.git
+// and ssh://git@github.com/go-pogo/buildinfo become
+// https://github.com/go-pogo/buildinfo. Use it to set BuildInfo.RepoURL from
+// the output of `git remote get-url origin`.
+func NormalizeRepoURL(remote string) string {
+	remote = strings.TrimSpace(remote)
+	if remote == "" {
+		return ""
+	}
+
+	// scp-like syntax, e.g. git@github.com:go-pogo/buildinfo.git
+	if !strings.Contains(remote, "://") {
+		if i := strings.Index(remote, ":"); i > 0 {
+			host := remote[:i]
+			if j := strings.LastIndex(host, "@"); j >= 0 {
+				host = host[j+1:]
+			}
+			remote = "https://" + host + "/" + strings.TrimPrefix(remote[i+1:], "/")
+		}
+	}
+
+	u, err := url.Parse(remote)
+	if err != nil || u.Host == "" {
+		return remote
+	}
+
+	switch u.Scheme {
+	case "ssh", "git", "git+ssh", "http":
+		u.Scheme = "https"
+	}
+	u.User = nil
+	u.Host = u.Hostname()
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), ".git")
+	return u.String()
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import "strings"
+
+// SentryRelease returns the release identifier of BuildInfo bld in the format
+// recommended by Sentry: name@version+revision, e.g. myapp@v1.2.3+fedcba.
+// The name is BuildInfo.Name. The +revision suffix is omitted when bld does
+// not contain a revision.
+func SentryRelease(bld *BuildInfo) string {
+	var sb strings.Builder
+	if name := bld.Name(); name != "" {
+		sb.WriteString(name)
+		sb.WriteByte('@')
+	}
+	sb.WriteString(bld.Version())
+	if rev := bld.Revision(); rev != "" {
+		sb.WriteByte('+')
+		sb.WriteString(rev)
+	}
+	return sb.String()
+}
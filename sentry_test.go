@@ -0,0 +1,52 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentryRelease(t *testing.T) {
+	tests := map[string]struct {
+		input BuildInfo
+		want  string
+	}{
+		"alt name": {
+			input: BuildInfo{
+				info:       &debug.BuildInfo{},
+				AltName:    "myapp",
+				AltVersion: "v1.2.3",
+			},
+			want: "myapp@v1.2.3",
+		},
+		"module path": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					Main: debug.Module{Path: "github.com/go-pogo/myapp"},
+					Settings: []debug.BuildSetting{
+						{Key: keyRevision, Value: "fedcba"},
+					},
+				},
+				AltVersion: "v1.2.3",
+			},
+			want: "myapp@v1.2.3+fedcba",
+		},
+		"no name": {
+			input: BuildInfo{
+				info:       &debug.BuildInfo{},
+				AltVersion: "v1.2.3",
+			},
+			want: "v1.2.3",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Exactly(t, tc.want, SentryRelease(&tc.input))
+		})
+	}
+}
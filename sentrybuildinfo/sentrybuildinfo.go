@@ -0,0 +1,28 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sentrybuildinfo sets the release and dist of sentry.ClientOptions
+// from the build information, so crash reports always identify the build.
+//
+//	opts := sentry.ClientOptions{Dsn: dsn}
+//	sentrybuildinfo.SetClientOptions(&opts, bld)
+//	err := sentry.Init(opts)
+package sentrybuildinfo
+
+import (
+	"github.com/getsentry/sentry-go"
+	"github.com/go-pogo/buildinfo"
+)
+
+// SetClientOptions sets the Release of sentry.ClientOptions opts to
+// buildinfo.SentryRelease, and its Dist to the revision of BuildInfo bld.
+// Fields which are already set are left untouched.
+func SetClientOptions(opts *sentry.ClientOptions, bld *buildinfo.BuildInfo) {
+	if opts.Release == "" {
+		opts.Release = buildinfo.SentryRelease(bld)
+	}
+	if opts.Dist == "" {
+		opts.Dist = bld.Revision()
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sentrybuildinfo
+
+import (
+	"testing"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/go-pogo/buildinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetClientOptions(t *testing.T) {
+	bld := buildinfo.BuildInfo{AltName: "myapp", AltVersion: "v1.2.3"}
+
+	t.Run("empty", func(t *testing.T) {
+		var opts sentry.ClientOptions
+		SetClientOptions(&opts, &bld)
+		assert.Exactly(t, buildinfo.SentryRelease(&bld), opts.Release)
+		assert.Exactly(t, bld.Revision(), opts.Dist)
+	})
+	t.Run("keep existing", func(t *testing.T) {
+		opts := sentry.ClientOptions{Release: "custom", Dist: "dist"}
+		SetClientOptions(&opts, &bld)
+		assert.Exactly(t, "custom", opts.Release)
+		assert.Exactly(t, "dist", opts.Dist)
+	})
+}
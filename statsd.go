@@ -0,0 +1,177 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-pogo/errors"
+)
+
+const ErrEmitterStarted = "statsd emitter is already started"
+
+// DefaultStatsdInterval is the default interval in which StatsdEmitter sends
+// the metric.
+const DefaultStatsdInterval = 30 * time.Second
+
+// StatsdOption configures the StatsdEmitter that is created with
+// NewStatsdEmitter.
+type StatsdOption func(e *StatsdEmitter)
+
+// StatsdInterval sets the interval in which the metric is sent. It defaults
+// to DefaultStatsdInterval, which is also kept when d is not positive.
+func StatsdInterval(d time.Duration) StatsdOption {
+	return func(e *StatsdEmitter) {
+		if d > 0 {
+			e.interval = d
+		}
+	}
+}
+
+// StatsdNamespace prefixes the metric name with namespace, e.g.
+// myapp.buildinfo.
+func StatsdNamespace(namespace string) StatsdOption {
+	return func(e *StatsdEmitter) { e.namespace = namespace }
+}
+
+// StatsdTags adds additional tags, e.g. "env:prod", to the metric.
+func StatsdTags(tags ...string) StatsdOption {
+	return func(e *StatsdEmitter) { e.tags = append(e.tags, tags...) }
+}
+
+// StatsdEmitter periodically sends a MetricName gauge with a constant value of
+// 1 to a StatsD or DogStatsD server, using the DogStatsD tag extension to
+// include the build information from BuildInfo.Map.
+type StatsdEmitter struct {
+	addr      string
+	interval  time.Duration
+	namespace string
+	tags      []string
+	packet    []byte
+
+	mu   sync.Mutex
+	conn net.Conn
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewStatsdEmitter creates a new StatsdEmitter which sends the build
+// information of BuildInfo bld to the StatsD server at UDP address addr, e.g.
+// "127.0.0.1:8125". The emitter does not send anything until it is started
+// with StatsdEmitter.Start.
+func NewStatsdEmitter(addr string, bld *BuildInfo, opts ...StatsdOption) *StatsdEmitter {
+	e := StatsdEmitter{
+		addr:     addr,
+		interval: DefaultStatsdInterval,
+	}
+	for _, opt := range opts {
+		opt(&e)
+	}
+
+	e.packet = statsdPacket(e.namespace, bld.Map(), e.tags)
+	return &e
+}
+
+// Start connects to the StatsD server, sends the metric and keeps sending it
+// each interval until Stop is called. It returns an error when the emitter is
+// already started or the address cannot be resolved.
+func (e *StatsdEmitter) Start() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn != nil {
+		return errors.New(ErrEmitterStarted)
+	}
+
+	conn, err := net.Dial("udp", e.addr)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	e.conn = conn
+	e.stop = make(chan struct{})
+	e.done = make(chan struct{})
+	go e.run(conn, e.stop, e.done)
+	return nil
+}
+
+func (e *StatsdEmitter) run(conn net.Conn, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		// sending over udp is best effort, a missed packet is resent with the
+		// next tick
+		_, _ = conn.Write(e.packet)
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops sending the metric and closes the connection. It is safe to call
+// Stop on an emitter which is not started.
+func (e *StatsdEmitter) Stop() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn == nil {
+		return nil
+	}
+
+	close(e.stop)
+	<-e.done
+
+	err := e.conn.Close()
+	e.conn = nil
+	return errors.WithStack(err)
+}
+
+// statsdPacket returns a DogStatsD gauge packet, e.g.
+// myapp.buildinfo:1|g|#goversion:go1.22.0,version:v1.2.3
+func statsdPacket(namespace string, m map[string]string, extra []string) []byte {
+	tags := make([]string, 0, len(m)+len(extra))
+	for k, v := range m {
+		tags = append(tags, statsdTag(k)+":"+statsdTag(v))
+	}
+	sort.Strings(tags)
+	for _, t := range extra {
+		tags = append(tags, statsdTag(t))
+	}
+
+	var sb strings.Builder
+	if namespace != "" {
+		sb.WriteString(namespace)
+		sb.WriteByte('.')
+	}
+	sb.WriteString(MetricName)
+	sb.WriteString(":1|g")
+	if len(tags) != 0 {
+		sb.WriteString("|#")
+		sb.WriteString(strings.Join(tags, ","))
+	}
+	return []byte(sb.String())
+}
+
+// statsdTag replaces characters which have a special meaning within the
+// DogStatsD protocol.
+func statsdTag(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ',', '|', '#', '\n':
+			return '_'
+		}
+		return r
+	}, s)
+}
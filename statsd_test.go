@@ -0,0 +1,107 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsdEmitter(t *testing.T) {
+	lis, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer lis.Close()
+
+	bld := BuildInfo{AltVersion: "v1.2.3"}
+	e := NewStatsdEmitter(lis.LocalAddr().String(), &bld,
+		StatsdNamespace("myapp"),
+		StatsdInterval(time.Millisecond),
+	)
+	assert.NoError(t, e.Start())
+	assert.Error(t, e.Start())
+
+	buf := make([]byte, 512)
+	_ = lis.SetReadDeadline(time.Now().Add(time.Second))
+	for i := 0; i < 2; i++ {
+		n, _, err := lis.ReadFrom(buf)
+		assert.NoError(t, err)
+		assert.Exactly(t,
+			"myapp.buildinfo:1|g|#goversion:"+runtime.Version()+",version:v1.2.3",
+			string(buf[:n]),
+		)
+	}
+
+	assert.NoError(t, e.Stop())
+	assert.NoError(t, e.Stop())
+	// can be restarted after being stopped
+	assert.NoError(t, e.Start())
+	assert.NoError(t, e.Stop())
+}
+
+func TestStatsdInterval(t *testing.T) {
+	tests := map[string]struct {
+		input time.Duration
+		want  time.Duration
+	}{
+		"positive": {input: time.Second, want: time.Second},
+		"zero":     {input: 0, want: DefaultStatsdInterval},
+		"negative": {input: -time.Second, want: DefaultStatsdInterval},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := NewStatsdEmitter("127.0.0.1:8125", &BuildInfo{}, StatsdInterval(tc.input))
+			assert.Exactly(t, tc.want, e.interval)
+			// must not panic because of a non-positive ticker interval
+			assert.NoError(t, e.Start())
+			assert.NoError(t, e.Stop())
+		})
+	}
+}
+
+func TestStatsdPacket(t *testing.T) {
+	tests := map[string]struct {
+		namespace string
+		m         map[string]string
+		extra     []string
+		want      string
+	}{
+		"empty": {
+			want: "buildinfo:1|g",
+		},
+		"namespace": {
+			namespace: "myapp",
+			m:         map[string]string{"version": "v1.2.3"},
+			want:      "myapp.buildinfo:1|g|#version:v1.2.3",
+		},
+		"sorted": {
+			m: map[string]string{
+				"version":      "v1.2.3",
+				"vcs.revision": "abc",
+				"goversion":    "go1.22",
+			},
+			want: "buildinfo:1|g|#goversion:go1.22,vcs.revision:abc,version:v1.2.3",
+		},
+		"extra tags": {
+			m:     map[string]string{"version": "v1.2.3"},
+			extra: []string{"env:prod", "team:a,b"},
+			want:  "buildinfo:1|g|#version:v1.2.3,env:prod,team:a_b",
+		},
+		"special chars": {
+			m:    map[string]string{"version": "v1|2#3"},
+			want: "buildinfo:1|g|#version:v1_2_3",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Exactly(t, tc.want, string(statsdPacket(tc.namespace, tc.m, tc.extra)))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"net/http"
+	"strings"
+)
+
+// UserAgent returns a User-Agent header value which identifies the app and its
+// build, e.g. "myapp/v1.2.3 (fedcba; go1.22.0)". When appName is empty, the
+// name is BuildInfo.Name.
+// The revision is omitted when bld does not contain a revision.
+func (bld *BuildInfo) UserAgent(appName string) string {
+	if appName == "" {
+		appName = bld.Name()
+	}
+
+	var sb strings.Builder
+	sb.WriteString(appName)
+	sb.WriteByte('/')
+	sb.WriteString(bld.Version())
+	sb.WriteString(" (")
+	if rev := bld.Revision(); rev != "" {
+		sb.WriteString(rev)
+		sb.WriteString("; ")
+	}
+	sb.WriteString(bld.GoVersion())
+	sb.WriteByte(')')
+	return sb.String()
+}
+
+// TransportOption configures the http.RoundTripper that is created with
+// Transport.
+type TransportOption func(t *transport)
+
+// TransportAppName sets the app name which is used in the User-Agent header.
+// See BuildInfo.UserAgent for the default name.
+func TransportAppName(name string) TransportOption {
+	return func(t *transport) { t.appName = name }
+}
+
+// SetVersionHeader additionally sets the HeaderVersion and HeaderRevision
+// headers on outgoing requests.
+func SetVersionHeader() TransportOption {
+	return func(t *transport) { t.versionHeader = true }
+}
+
+// Transport wraps http.RoundTripper rt and sets the User-Agent header, as
+// returned by BuildInfo.UserAgent, on outgoing requests which do not already
+// have a User-Agent. It uses http.DefaultTransport when rt is nil.
+//
+//	client := &http.Client{Transport: buildinfo.Transport(nil, bld)}
+func Transport(rt http.RoundTripper, bld *BuildInfo, opts ...TransportOption) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	t := transport{next: rt}
+	for _, opt := range opts {
+		opt(&t)
+	}
+
+	t.userAgent = bld.UserAgent(t.appName)
+	t.version, t.revision = bld.Version(), bld.Revision()
+	return &t
+}
+
+type transport struct {
+	next          http.RoundTripper
+	appName       string
+	versionHeader bool
+
+	userAgent string
+	version   string
+	revision  string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a RoundTripper should not modify the request
+	req = req.Clone(req.Context())
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+	if t.versionHeader {
+		req.Header.Set(HeaderVersion, t.version)
+		if t.revision != "" {
+			req.Header.Set(HeaderRevision, t.revision)
+		}
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildinfo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildInfo_UserAgent(t *testing.T) {
+	tests := map[string]struct {
+		input   BuildInfo
+		appName string
+		want    string
+	}{
+		"app name": {
+			input: BuildInfo{
+				info:       &debug.BuildInfo{GoVersion: "go1.22.0"},
+				AltVersion: "v1.2.3",
+			},
+			appName: "myapp",
+			want:    "myapp/v1.2.3 (go1.22.0)",
+		},
+		"module path": {
+			input: BuildInfo{
+				info: &debug.BuildInfo{
+					GoVersion: "go1.22.0",
+					Main:      debug.Module{Path: "github.com/go-pogo/myapp"},
+					Settings: []debug.BuildSetting{
+						{Key: keyRevision, Value: "fedcba"},
+					},
+				},
+				AltVersion: "v1.2.3",
+			},
+			want: "myapp/v1.2.3 (fedcba; go1.22.0)",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Exactly(t, tc.want, tc.input.UserAgent(tc.appName))
+		})
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var have http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
+		have = req.Header
+	}))
+	defer srv.Close()
+
+	bld := BuildInfo{AltVersion: "v1.2.3"}
+	ua := bld.UserAgent("myapp")
+
+	tests := map[string]struct {
+		opts        []TransportOption
+		userAgent   string
+		wantUA      string
+		wantVersion string
+	}{
+		"default": {
+			opts:   []TransportOption{TransportAppName("myapp")},
+			wantUA: ua,
+		},
+		"keep user agent": {
+			userAgent: "custom",
+			wantUA:    "custom",
+		},
+		"version header": {
+			opts:        []TransportOption{TransportAppName("myapp"), SetVersionHeader()},
+			wantUA:      ua,
+			wantVersion: "v1.2.3",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+			if tc.userAgent != "" {
+				req.Header.Set("User-Agent", tc.userAgent)
+			}
+
+			client := http.Client{Transport: Transport(nil, &bld, tc.opts...)}
+			res, err := client.Do(req)
+			assert.NoError(t, err)
+			_ = res.Body.Close()
+
+			assert.Exactly(t, tc.wantUA, have.Get("User-Agent"))
+			assert.Exactly(t, tc.wantVersion, have.Get(HeaderVersion))
+			assert.Empty(t, req.Header.Get(HeaderVersion))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package zapbuildinfo provides helpers to attach the build information to
+// zap loggers.
+//
+//	logger = logger.With(zapbuildinfo.Fields(bld)...)
+//	// or as a single nested object
+//	logger = logger.With(zapbuildinfo.Field(bld))
+package zapbuildinfo
+
+import (
+	"sort"
+	"time"
+
+	"github.com/go-pogo/buildinfo"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// FieldKey is the key of the zap.Field returned by Field.
+const FieldKey = "build"
+
+var _ zapcore.ObjectMarshaler = (*ObjectMarshaler)(nil)
+
+// ObjectMarshaler is a zapcore.ObjectMarshaler which encodes the version,
+// revision, time, build number, repository url and go version of a
+// buildinfo.BuildInfo.
+type ObjectMarshaler buildinfo.BuildInfo
+
+// Marshaler returns BuildInfo bld as an ObjectMarshaler.
+func Marshaler(bld *buildinfo.BuildInfo) *ObjectMarshaler {
+	return (*ObjectMarshaler)(bld)
+}
+
+// MarshalLogObject encodes the build information to zapcore.ObjectEncoder enc.
+// Empty fields, except for version and go version, are omitted.
+func (m *ObjectMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	bld := (*buildinfo.BuildInfo)(m)
+	enc.AddString("version", bld.Version())
+	if rev := bld.Revision(); rev != "" {
+		enc.AddString("revision", rev)
+	}
+	if t := bld.Time(); !t.IsZero() {
+		enc.AddString("time", t.Format(time.RFC3339))
+	}
+	if bld.BuildNumber != "" {
+		enc.AddString("buildnumber", bld.BuildNumber)
+	}
+	if bld.RepoURL != "" {
+		enc.AddString("repourl", bld.RepoURL)
+	}
+	enc.AddString("goversion", bld.GoVersion())
+	return nil
+}
+
+// Field returns the build information of BuildInfo bld as a single nested
+// zap.Field with key FieldKey.
+func Field(bld *buildinfo.BuildInfo) zap.Field {
+	return zap.Object(FieldKey, Marshaler(bld))
+}
+
+// Fields returns the build information of BuildInfo bld as flat zap.Field(s),
+// using the same keys as BuildInfo.Map, sorted by key.
+func Fields(bld *buildinfo.BuildInfo) []zap.Field {
+	m := bld.Map()
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := make([]zap.Field, 0, len(keys))
+	for _, key := range keys {
+		fields = append(fields, zap.String(key, m[key]))
+	}
+	return fields
+}
+
+// Logger returns zap.Logger l as a buildinfo.Logger, which can be used with
+// buildinfo.LogStartup.
+func Logger(l *zap.Logger) buildinfo.Logger {
+	return &logger{l.Sugar()}
+}
+
+type logger struct {
+	l *zap.SugaredLogger
+}
+
+func (l *logger) Info(msg string, keysAndValues ...any) {
+	l.l.Infow(msg, keysAndValues...)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zapbuildinfo
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestField(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	zap.New(core).With(Field(&buildinfo.BuildInfo{
+		AltVersion:  "v1.2.3",
+		BuildNumber: "42",
+		RepoURL:     "https://github.com/go-pogo/buildinfo",
+	})).Info("started")
+
+	entries := logs.All()
+	if !assert.Len(t, entries, 1) {
+		return
+	}
+	assert.Exactly(t, map[string]interface{}{
+		FieldKey: map[string]interface{}{
+			"version":     "v1.2.3",
+			"buildnumber": "42",
+			"repourl":     "https://github.com/go-pogo/buildinfo",
+			"goversion":   runtime.Version(),
+		},
+	}, entries[0].ContextMap())
+}
+
+func TestFields(t *testing.T) {
+	assert.Exactly(t,
+		[]zap.Field{
+			zap.String("buildnumber", "42"),
+			zap.String("goversion", runtime.Version()),
+			zap.String("repourl", "https://github.com/go-pogo/buildinfo"),
+			zap.String("version", "v1.2.3"),
+		},
+		Fields(&buildinfo.BuildInfo{
+			AltVersion:  "v1.2.3",
+			BuildNumber: "42",
+			RepoURL:     "https://github.com/go-pogo/buildinfo",
+		}),
+	)
+}
+
+func TestLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	buildinfo.LogStartup(Logger(zap.New(core)), &buildinfo.BuildInfo{
+		AltName:    "myapp",
+		AltVersion: "v1.2.3",
+	})
+
+	entries := logs.All()
+	if !assert.Len(t, entries, 1) {
+		return
+	}
+	assert.Exactly(t, buildinfo.StartupMessage, entries[0].Message)
+	assert.Exactly(t, map[string]interface{}{
+		"app":       "myapp",
+		"version":   "v1.2.3",
+		"goversion": runtime.Version(),
+	}, entries[0].ContextMap())
+}
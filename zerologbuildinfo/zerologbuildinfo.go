@@ -0,0 +1,67 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package zerologbuildinfo provides helpers to attach the build information to
+// zerolog loggers and events.
+//
+//	logger = zerologbuildinfo.With(logger.With(), bld).Logger()
+//	// or as a single nested object
+//	logger.Info().Object("build", zerologbuildinfo.Marshaler(bld)).Msg("started")
+package zerologbuildinfo
+
+import (
+	"sort"
+	"time"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/rs/zerolog"
+)
+
+var _ zerolog.LogObjectMarshaler = (*ObjectMarshaler)(nil)
+
+// ObjectMarshaler is a zerolog.LogObjectMarshaler which encodes the version,
+// revision, time, build number, repository url and go version of a
+// buildinfo.BuildInfo.
+type ObjectMarshaler buildinfo.BuildInfo
+
+// Marshaler returns BuildInfo bld as an ObjectMarshaler.
+func Marshaler(bld *buildinfo.BuildInfo) *ObjectMarshaler {
+	return (*ObjectMarshaler)(bld)
+}
+
+// MarshalZerologObject adds the build information to zerolog.Event e. Empty
+// fields, except for version and go version, are omitted.
+func (m *ObjectMarshaler) MarshalZerologObject(e *zerolog.Event) {
+	bld := (*buildinfo.BuildInfo)(m)
+	e.Str("version", bld.Version())
+	if rev := bld.Revision(); rev != "" {
+		e.Str("revision", rev)
+	}
+	if t := bld.Time(); !t.IsZero() {
+		e.Str("time", t.Format(time.RFC3339))
+	}
+	if bld.BuildNumber != "" {
+		e.Str("buildnumber", bld.BuildNumber)
+	}
+	if bld.RepoURL != "" {
+		e.Str("repourl", bld.RepoURL)
+	}
+	e.Str("goversion", bld.GoVersion())
+}
+
+// With adds the build information of BuildInfo bld as flat fields, using the
+// same keys as BuildInfo.Map sorted by key, to zerolog.Context ctx.
+func With(ctx zerolog.Context, bld *buildinfo.BuildInfo) zerolog.Context {
+	m := bld.Map()
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		ctx = ctx.Str(key, m[key])
+	}
+	return ctx
+}
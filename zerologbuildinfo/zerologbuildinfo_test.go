@@ -0,0 +1,46 @@
+// Copyright (c) 2024, Roel Schut. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zerologbuildinfo
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	"github.com/go-pogo/buildinfo"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+var bld = buildinfo.BuildInfo{
+	AltVersion:  "v1.2.3",
+	BuildNumber: "42",
+	RepoURL:     "https://github.com/go-pogo/buildinfo",
+}
+
+func TestMarshaler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	logger.Info().
+		Object("build", Marshaler(&bld)).
+		Msg("started")
+
+	assert.JSONEq(t,
+		`{"level":"info","build":{"version":"v1.2.3","buildnumber":"42","repourl":"https://github.com/go-pogo/buildinfo","goversion":"`+runtime.Version()+`"},"message":"started"}`,
+		buf.String(),
+	)
+}
+
+func TestWith(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	logger = With(logger.With(), &bld).Logger()
+	logger.Info().Msg("started")
+
+	assert.JSONEq(t,
+		`{"level":"info","buildnumber":"42","goversion":"`+runtime.Version()+`","repourl":"https://github.com/go-pogo/buildinfo","version":"v1.2.3","message":"started"}`,
+		buf.String(),
+	)
+}